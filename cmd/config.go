@@ -0,0 +1,73 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/initializer"
+	"github.com/senzing-garage/init-database/senzingconfig"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ConfigShort string = "Install or update the Senzing configuration"
+	ConfigUse   string = "config"
+)
+
+var ConfigLong = `
+Install or update the Senzing configuration, without creating the Senzing schema or loading data.
+The Senzing schema must already exist in each database.
+Subcommands list and manage the stored Senzing configurations.
+	`
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigCmd represents the config command.
+var ConfigCmd = &cobra.Command{
+	Use:    ConfigUse,
+	Short:  ConfigShort,
+	Long:   ConfigLong,
+	PreRun: ConfigPreRun,
+	RunE:   ConfigRunE,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForPhases)
+}
+
+// Used in construction of cobra.Command.
+func ConfigRunE(_ *cobra.Command, _ []string) error {
+	return runPhases(context.Background(), initializer.PhaseConfig)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Get the SenzingConfig described by the command line parameters.
+func getSenzingConfig(ctx context.Context) (senzingconfig.SenzingConfig, error) {
+	anInitializer, err := getInitializer(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "getInitializer")
+	}
+
+	result, err := anInitializer.GetSenzingConfig(ctx)
+
+	return result, wraperror.Errorf(err, "GetSenzingConfig")
+}
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	RootCmd.AddCommand(ConfigCmd)
+	cmdhelper.Init(ConfigCmd, ContextVariablesForPhases)
+}
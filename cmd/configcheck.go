@@ -0,0 +1,120 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"os"
+	"slices"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingconfig"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	envarExpected    string = "SENZING_TOOLS_EXPECTED"
+	ConfigCheckShort string = "Check the default Senzing configuration against an expected configuration"
+	ConfigCheckUse   string = "check"
+)
+
+var ConfigCheckLong = `
+Check the default Senzing configuration against the file given by --expected, without changing anything.
+The file is one of:
+  - Senzing configuration JSON (see "config export"), compared as by "config diff"
+  - a list of datasources, one per line or a JSON/YAML array (see --datasources-file),
+    compared with the registered datasources; datasources in the Senzing configuration template need not be listed
+In the text output, "-" marks what is expected but missing, "+" marks what is present but not expected,
+and "~" marks values that changed from the expectation.
+The command exits non-zero if there are differences, so it can detect drift in CI pipelines.
+	`
+
+// File of the expected Senzing configuration or datasources.
+var OptionExpected = option.ContextVariable{
+	Arg:     "expected",
+	Default: option.OsLookupEnvString(envarExpected, ""),
+	Envar:   envarExpected,
+	Help:    "Path to file of expected Senzing configuration JSON or datasources [%s]",
+	Type:    optiontype.String,
+}
+
+var ContextVariablesForConfigCheck = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		option.JSONOutput,
+		OptionExpected,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigCheckCmd represents the "config check" command.
+var ConfigCheckCmd = &cobra.Command{
+	Use:          ConfigCheckUse,
+	Short:        ConfigCheckShort,
+	Long:         ConfigCheckLong,
+	PreRun:       ConfigCheckPreRun,
+	RunE:         ConfigCheckRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigCheckPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigCheck)
+}
+
+// Used in construction of cobra.Command.
+func ConfigCheckRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	expectedFile := viper.GetString(OptionExpected.Arg)
+	if len(expectedFile) == 0 {
+		return wraperror.Errorf(errForPackage, "--%s is required", OptionExpected.Arg)
+	}
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	configDiff, err := senzingConfig.CheckConfig(ctx, expectedFile)
+	if err != nil {
+		return wraperror.Errorf(err, "CheckConfig")
+	}
+
+	err = ConfigDiffAction(os.Stdout, configDiff, viper.GetBool(option.JSONOutput.Arg))
+	if err != nil {
+		return err
+	}
+
+	return ConfigCheckResult(configDiff)
+}
+
+// ConfigCheckResult returns an error if the default Senzing configuration differs from the expectation.
+func ConfigCheckResult(configDiff senzingconfig.ConfigDiff) error {
+	if senzingconfig.HasConfigDifferences(configDiff) {
+		return wraperror.Errorf(errForPackage, "%s differs from %s", configDiff.To, configDiff.From)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigCheckCmd)
+	cmdhelper.Init(ConfigCheckCmd, ContextVariablesForConfigCheck)
+}
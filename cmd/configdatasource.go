@@ -0,0 +1,134 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"slices"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	ConfigDatasourceShort       string = "Remove or rename datasources in the default Senzing configuration"
+	ConfigDatasourceUse         string = "datasource"
+	ConfigDatasourceRemoveShort string = "Remove datasources from the default Senzing configuration"
+	ConfigDatasourceRemoveUse   string = "remove DATASOURCE..."
+	ConfigDatasourceRenameShort string = "Rename a datasource in the default Senzing configuration"
+	ConfigDatasourceRenameUse   string = "rename OLD_DATASOURCE NEW_DATASOURCE"
+)
+
+var ConfigDatasourceLong = `
+Remove or rename datasources in the default Senzing configuration.
+Each change is saved as a new default Senzing configuration.
+	`
+
+var ConfigDatasourceRemoveLong = `
+Remove datasources from the default Senzing configuration.
+A datasource that still has records is not removed, unless --force is given.
+	`
+
+var ConfigDatasourceRenameLong = `
+Rename a datasource in the default Senzing configuration.
+The new datasource code is registered and the old one is removed.
+Records are not moved, so a datasource that still has records is not renamed, unless --force is given.
+	`
+
+var ContextVariablesForConfigDatasource = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		OptionForce,
+		OptionConfigLabel,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigDatasourceCmd represents the "config datasource" command.
+var ConfigDatasourceCmd = &cobra.Command{
+	Use:   ConfigDatasourceUse,
+	Short: ConfigDatasourceShort,
+	Long:  ConfigDatasourceLong,
+}
+
+// ConfigDatasourceRemoveCmd represents the "config datasource remove" command.
+var ConfigDatasourceRemoveCmd = &cobra.Command{
+	Use:          ConfigDatasourceRemoveUse,
+	Short:        ConfigDatasourceRemoveShort,
+	Long:         ConfigDatasourceRemoveLong,
+	Args:         cobra.MinimumNArgs(1),
+	PreRun:       ConfigDatasourcePreRun,
+	RunE:         ConfigDatasourceRemoveRunE,
+	SilenceUsage: true,
+}
+
+// ConfigDatasourceRenameCmd represents the "config datasource rename" command.
+var ConfigDatasourceRenameCmd = &cobra.Command{
+	Use:          ConfigDatasourceRenameUse,
+	Short:        ConfigDatasourceRenameShort,
+	Long:         ConfigDatasourceRenameLong,
+	Args:         cobra.ExactArgs(2), //nolint:mnd
+	PreRun:       ConfigDatasourcePreRun,
+	RunE:         ConfigDatasourceRenameRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigDatasourcePreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigDatasource)
+}
+
+// Used in construction of cobra.Command.
+func ConfigDatasourceRemoveRunE(_ *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	err = senzingConfig.UnregisterDataSources(ctx, args, viper.GetBool(OptionForce.Arg))
+
+	return wraperror.Errorf(err, "UnregisterDataSources: %v", args)
+}
+
+// Used in construction of cobra.Command.
+func ConfigDatasourceRenameRunE(_ *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if len(args) != 2 { //nolint:mnd
+		return wraperror.Errorf(errForPackage, "expected OLD_DATASOURCE NEW_DATASOURCE, got %v", args)
+	}
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	err = senzingConfig.RenameDataSource(ctx, args[0], args[1], viper.GetBool(OptionForce.Arg))
+
+	return wraperror.Errorf(err, "RenameDataSource: %s to %s", args[0], args[1])
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigDatasourceCmd)
+	ConfigDatasourceCmd.AddCommand(ConfigDatasourceRemoveCmd)
+	ConfigDatasourceCmd.AddCommand(ConfigDatasourceRenameCmd)
+	cmdhelper.Init(ConfigDatasourceRemoveCmd, ContextVariablesForConfigDatasource)
+	cmdhelper.Init(ConfigDatasourceRenameCmd, ContextVariablesForConfigDatasource)
+}
@@ -0,0 +1,148 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingconfig"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	ConfigDiffShort string = "List the differences between two Senzing configurations"
+	ConfigDiffUse   string = "diff CONFIG_1 CONFIG_2"
+)
+
+var ConfigDiffLong = `
+List the differences between two Senzing configurations, grouped into
+datasources, features, attributes, thresholds, rules, and other.
+Each configuration is one of:
+  - the ID of a Senzing configuration stored in the database (see "config history")
+  - a file containing Senzing configuration JSON (see "config export")
+  - Senzing settings JSON or a database URL of another repository, whose default configuration is used
+In the text output, "+" marks records only in CONFIG_2, "-" marks records only in CONFIG_1,
+and "~" marks values that changed from CONFIG_1 to CONFIG_2.
+	`
+
+var ContextVariablesForConfigDiff = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		option.JSONOutput,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigDiffCmd represents the "config diff" command.
+var ConfigDiffCmd = &cobra.Command{
+	Use:          ConfigDiffUse,
+	Short:        ConfigDiffShort,
+	Long:         ConfigDiffLong,
+	Args:         cobra.ExactArgs(2), //nolint:mnd
+	PreRun:       ConfigDiffPreRun,
+	RunE:         ConfigDiffRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigDiffPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigDiff)
+}
+
+// Used in construction of cobra.Command.
+func ConfigDiffRunE(_ *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if len(args) != 2 { //nolint:mnd
+		return wraperror.Errorf(errForPackage, "expected CONFIG_1 CONFIG_2, got %v", args)
+	}
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	configDiff, err := senzingConfig.DiffConfigs(ctx, args[0], args[1])
+	if err != nil {
+		return wraperror.Errorf(err, "DiffConfigs")
+	}
+
+	return ConfigDiffAction(os.Stdout, configDiff, viper.GetBool(option.JSONOutput.Arg))
+}
+
+// ConfigDiffAction writes the differences between two Senzing configurations as text or JSON.
+func ConfigDiffAction(out io.Writer, configDiff senzingconfig.ConfigDiff, isJSON bool) error {
+	if isJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(configDiff); err != nil {
+			return wraperror.Errorf(err, "encoding config diff")
+		}
+
+		return nil
+	}
+
+	var builder strings.Builder
+
+	_, _ = fmt.Fprintf(&builder, "--- %s\n+++ %s\n", configDiff.From, configDiff.To)
+
+	for _, section := range configDiff.Sections {
+		if len(section.Added)+len(section.Removed)+len(section.Updated) == 0 {
+			_, _ = fmt.Fprintf(&builder, "%s: no differences\n", section.Name)
+
+			continue
+		}
+
+		_, _ = fmt.Fprintf(&builder, "%s:\n", section.Name)
+
+		for _, key := range section.Removed {
+			_, _ = fmt.Fprintf(&builder, "  - %s\n", key)
+		}
+
+		for _, key := range section.Added {
+			_, _ = fmt.Fprintf(&builder, "  + %s\n", key)
+		}
+
+		for _, change := range section.Updated {
+			_, _ = fmt.Fprintf(
+				&builder,
+				"  ~ %s: %s -> %s\n",
+				strings.TrimSpace(change.Key+" "+change.Field),
+				describeConfigValue(change.OldValue),
+				describeConfigValue(change.NewValue),
+			)
+		}
+	}
+
+	_, err := io.WriteString(out, builder.String())
+
+	return wraperror.Errorf(err, "printing config diff")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigDiffCmd)
+	cmdhelper.Init(ConfigDiffCmd, ContextVariablesForConfigDiff)
+}
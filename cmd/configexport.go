@@ -0,0 +1,142 @@
+/*
+ */
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	envarOutputFile            string = "SENZING_TOOLS_OUTPUT_FILE"
+	envarStripVolatileFields   string = "SENZING_TOOLS_STRIP_VOLATILE_FIELDS"
+	ConfigExportShort          string = "Export the default Senzing configuration as JSON"
+	ConfigExportUse            string = "export"
+	configExportFilePermission        = 0o600
+)
+
+var ConfigExportLong = `
+Export the default Senzing configuration as pretty-printed JSON to a file or, by default, to stdout.
+With --strip-volatile-fields, fields describing the Senzing build are removed
+so that exports from different environments can be compared.
+If the configuration has labels (see --config-label), its comment and labels are added as CONFIG_METADATA,
+unless --strip-volatile-fields is given.
+	`
+
+// File to which output is written.
+var OptionOutputFile = option.ContextVariable{
+	Arg:     "output-file",
+	Default: option.OsLookupEnvString(envarOutputFile, ""),
+	Envar:   envarOutputFile,
+	Help:    "Path to file for output. If not specified, output is written to stdout [%s]",
+	Type:    optiontype.String,
+}
+
+// Remove fields that change without the Senzing configuration changing.
+var OptionStripVolatileFields = option.ContextVariable{
+	Arg:     "strip-volatile-fields",
+	Default: option.OsLookupEnvBool(envarStripVolatileFields, false),
+	Envar:   envarStripVolatileFields,
+	Help:    "Remove Senzing build information from the exported configuration [%s]",
+	Type:    optiontype.Bool,
+}
+
+var ContextVariablesForConfigExport = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		OptionOutputFile,
+		OptionStripVolatileFields,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigExportCmd represents the "config export" command.
+var ConfigExportCmd = &cobra.Command{
+	Use:          ConfigExportUse,
+	Short:        ConfigExportShort,
+	Long:         ConfigExportLong,
+	PreRun:       ConfigExportPreRun,
+	RunE:         ConfigExportRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigExportPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigExport)
+}
+
+// Used in construction of cobra.Command.
+func ConfigExportRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	configDefinition, err := senzingConfig.ExportConfig(ctx, viper.GetBool(OptionStripVolatileFields.Arg))
+	if err != nil {
+		return wraperror.Errorf(err, "ExportConfig")
+	}
+
+	outputFile := viper.GetString(OptionOutputFile.Arg)
+	if len(outputFile) == 0 {
+		return ConfigExportAction(os.Stdout, configDefinition)
+	}
+
+	var buffer bytes.Buffer
+
+	err = ConfigExportAction(&buffer, configDefinition)
+	if err != nil {
+		return wraperror.Errorf(err, "ConfigExportAction")
+	}
+
+	err = os.WriteFile(filepath.Clean(outputFile), buffer.Bytes(), configExportFilePermission)
+
+	return wraperror.Errorf(err, "os.WriteFile: %s", outputFile)
+}
+
+// ConfigExportAction writes a Senzing configuration as pretty-printed JSON.
+func ConfigExportAction(out io.Writer, configDefinition string) error {
+	var buffer bytes.Buffer
+
+	err := json.Indent(&buffer, []byte(configDefinition), "", "  ")
+	if err != nil {
+		return wraperror.Errorf(err, "json.Indent")
+	}
+
+	buffer.WriteString("\n")
+
+	_, err = buffer.WriteTo(out)
+
+	return wraperror.Errorf(err, "printing Senzing configuration")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigExportCmd)
+	cmdhelper.Init(ConfigExportCmd, ContextVariablesForConfigExport)
+}
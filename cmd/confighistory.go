@@ -0,0 +1,142 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingconfig"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	ConfigHistoryShort string = "List the Senzing configurations stored in the database"
+	ConfigHistoryUse   string = "history"
+)
+
+var ConfigHistoryLong = `
+List every Senzing configuration stored in the database, oldest first.
+For each configuration: its ID, when it was created, its registered datasources, its labels, and its comment.
+The default configuration is marked with "*".
+	`
+
+var ContextVariablesForConfigHistory = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		option.JSONOutput,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigHistoryCmd represents the "config history" command.
+var ConfigHistoryCmd = &cobra.Command{
+	Use:          ConfigHistoryUse,
+	Short:        ConfigHistoryShort,
+	Long:         ConfigHistoryLong,
+	PreRun:       ConfigHistoryPreRun,
+	RunE:         ConfigHistoryRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigHistoryPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigHistory)
+}
+
+// Used in construction of cobra.Command.
+func ConfigHistoryRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	configHistory, err := senzingConfig.GetConfigHistory(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "GetConfigHistory")
+	}
+
+	return ConfigHistoryAction(os.Stdout, configHistory, viper.GetBool(option.JSONOutput.Arg))
+}
+
+// ConfigHistoryAction writes the stored Senzing configurations as a table or as JSON.
+func ConfigHistoryAction(out io.Writer, configHistory []senzingconfig.ConfigHistoryEntry, isJSON bool) error {
+	if isJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(configHistory); err != nil {
+			return wraperror.Errorf(err, "encoding config history")
+		}
+
+		return nil
+	}
+
+	tabWriter := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0) //nolint:mnd
+	_, _ = fmt.Fprintln(tabWriter, "DEFAULT\tCONFIG ID\tCREATED\tDATASOURCES\tLABELS\tCOMMENT")
+
+	for _, configHistoryEntry := range configHistory {
+		isDefault := ""
+		if configHistoryEntry.IsDefault {
+			isDefault = "*"
+		}
+
+		_, _ = fmt.Fprintf(
+			tabWriter,
+			"%s\t%d\t%s\t%s\t%s\t%s\n",
+			isDefault,
+			configHistoryEntry.ConfigID,
+			configHistoryEntry.CreatedOn,
+			strings.Join(configHistoryEntry.DataSources, " "),
+			describeConfigLabels(configHistoryEntry.Labels),
+			configHistoryEntry.Comment,
+		)
+	}
+
+	if err := tabWriter.Flush(); err != nil {
+		return wraperror.Errorf(err, "printing config history")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigHistoryCmd)
+	cmdhelper.Init(ConfigHistoryCmd, ContextVariablesForConfigHistory)
+}
+
+// Describe labels as "key=value" pairs, sorted by key.
+func describeConfigLabels(labels map[string]string) string {
+	result := []string{}
+
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		result = append(result, key+"="+labels[key])
+	}
+
+	return strings.Join(result, " ")
+}
@@ -0,0 +1,204 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingconfig"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	envarDryRun            string = "SENZING_TOOLS_DRY_RUN"
+	envarKeep              string = "SENZING_TOOLS_KEEP"
+	envarPinnedConfigIDs   string = "SENZING_TOOLS_PINNED_CONFIG_IDS"
+	ConfigPruneShort       string = "Remove old Senzing configurations from the database"
+	ConfigPruneUse         string = "prune"
+	defaultConfigPruneKeep        = 10
+)
+
+var ConfigPruneLong = `
+Remove old Senzing configurations from the database.
+The default configuration, the --keep most recent configurations, and pinned configurations are kept.
+A configuration is pinned by listing its ID in --pinned-config-ids
+or by creating it with --config-label ` + senzingconfig.PinnedConfigLabel + `=true.
+The default configuration is never removed, even if another process changes it during the prune.
+With --dry-run, the configurations that would be removed are listed and nothing is removed.
+	`
+
+// Only list what would be done.
+var OptionDryRun = option.ContextVariable{
+	Arg:     "dry-run",
+	Default: option.OsLookupEnvBool(envarDryRun, false),
+	Envar:   envarDryRun,
+	Help:    "List what would be removed without removing it [%s]",
+	Type:    optiontype.Bool,
+}
+
+// Number of most recent Senzing configurations to keep.
+var OptionKeep = option.ContextVariable{
+	Arg:     "keep",
+	Default: option.OsLookupEnvInt(envarKeep, defaultConfigPruneKeep),
+	Envar:   envarKeep,
+	Help:    "Number of most recent Senzing configurations to keep [%s]",
+	Type:    optiontype.Int,
+}
+
+// Identifiers of stored Senzing configurations to keep.
+var OptionPinnedConfigIDs = option.ContextVariable{
+	Arg:     "pinned-config-ids",
+	Default: []string{},
+	Envar:   envarPinnedConfigIDs,
+	Help:    "Identifiers of stored Senzing configurations to keep [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var ContextVariablesForConfigPrune = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		option.JSONOutput,
+		OptionDryRun,
+		OptionKeep,
+		OptionPinnedConfigIDs,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigPruneCmd represents the "config prune" command.
+var ConfigPruneCmd = &cobra.Command{
+	Use:          ConfigPruneUse,
+	Short:        ConfigPruneShort,
+	Long:         ConfigPruneLong,
+	PreRun:       ConfigPrunePreRun,
+	RunE:         ConfigPruneRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigPrunePreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigPrune)
+}
+
+// Used in construction of cobra.Command.
+func ConfigPruneRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	pinnedConfigIDs, err := parseConfigIDs(viper.GetStringSlice(OptionPinnedConfigIDs.Arg))
+	if err != nil {
+		return wraperror.Errorf(err, "--%s", OptionPinnedConfigIDs.Arg)
+	}
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	configPrunePlan, err := senzingConfig.PruneConfigs(
+		ctx,
+		viper.GetInt(OptionKeep.Arg),
+		pinnedConfigIDs,
+		viper.GetBool(OptionDryRun.Arg),
+	)
+	if err != nil {
+		return wraperror.Errorf(err, "PruneConfigs")
+	}
+
+	return ConfigPruneAction(os.Stdout, configPrunePlan, viper.GetBool(option.JSONOutput.Arg))
+}
+
+// ConfigPruneAction writes the Senzing configurations kept and removed by a prune as a table or JSON.
+func ConfigPruneAction(out io.Writer, configPrunePlan senzingconfig.ConfigPrunePlan, isJSON bool) error {
+	if isJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(configPrunePlan); err != nil {
+			return wraperror.Errorf(err, "encoding config prune")
+		}
+
+		return nil
+	}
+
+	removeAction := "removed"
+	if configPrunePlan.IsDryRun {
+		removeAction = "would remove"
+	}
+
+	tabWriter := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0) //nolint:mnd
+	_, _ = fmt.Fprintln(tabWriter, "ACTION\tCONFIG ID\tCREATED\tREASON\tCOMMENT")
+
+	for _, configPruneEntry := range configPrunePlan.Kept {
+		_, _ = fmt.Fprintf(
+			tabWriter,
+			"kept\t%d\t%s\t%s\t%s\n",
+			configPruneEntry.ConfigID,
+			configPruneEntry.CreatedOn,
+			configPruneEntry.Reason,
+			configPruneEntry.Comment,
+		)
+	}
+
+	for _, configPruneEntry := range configPrunePlan.Removed {
+		_, _ = fmt.Fprintf(
+			tabWriter,
+			"%s\t%d\t%s\t\t%s\n",
+			removeAction,
+			configPruneEntry.ConfigID,
+			configPruneEntry.CreatedOn,
+			configPruneEntry.Comment,
+		)
+	}
+
+	if err := tabWriter.Flush(); err != nil {
+		return wraperror.Errorf(err, "printing config prune")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigPruneCmd)
+	cmdhelper.Init(ConfigPruneCmd, ContextVariablesForConfigPrune)
+}
+
+// Parse identifiers of stored Senzing configurations.
+func parseConfigIDs(values []string) ([]int64, error) {
+	result := []int64{}
+
+	for _, value := range values {
+		configID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || configID <= 0 {
+			return nil, wraperror.Errorf(errForPackage, "invalid Senzing configuration ID: %q", value)
+		}
+
+		result = append(result, configID)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,95 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"slices"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	envarConfigID       string = "SENZING_TOOLS_CONFIG_ID"
+	ConfigRollbackShort string = "Make an earlier Senzing configuration the default"
+	ConfigRollbackUse   string = "rollback"
+)
+
+var ConfigRollbackLong = `
+Make an earlier Senzing configuration the default.
+Use "config history" to list the IDs of stored configurations.
+If another process changes the default configuration during the rollback, the rollback fails.
+	`
+
+// Identifier of a stored Senzing configuration.
+var OptionConfigID = option.ContextVariable{
+	Arg:     "config-id",
+	Default: option.OsLookupEnvInt(envarConfigID, 0),
+	Envar:   envarConfigID,
+	Help:    "Identifier of a stored Senzing configuration [%s]",
+	Type:    optiontype.Int,
+}
+
+var ContextVariablesForConfigRollback = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		OptionConfigID,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigRollbackCmd represents the "config rollback" command.
+var ConfigRollbackCmd = &cobra.Command{
+	Use:          ConfigRollbackUse,
+	Short:        ConfigRollbackShort,
+	Long:         ConfigRollbackLong,
+	PreRun:       ConfigRollbackPreRun,
+	RunE:         ConfigRollbackRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigRollbackPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigRollback)
+}
+
+// Used in construction of cobra.Command.
+func ConfigRollbackRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	configID := viper.GetInt64(OptionConfigID.Arg)
+	if configID == 0 {
+		return wraperror.Errorf(errForPackage, "--%s is required", OptionConfigID.Arg)
+	}
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	err = senzingConfig.RollbackConfig(ctx, configID)
+
+	return wraperror.Errorf(err, "RollbackConfig: %d", configID)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigRollbackCmd)
+	cmdhelper.Init(ConfigRollbackCmd, ContextVariablesForConfigRollback)
+}
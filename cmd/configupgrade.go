@@ -0,0 +1,207 @@
+/*
+ */
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingconfig"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	envarBaseConfigFile string = "SENZING_TOOLS_BASE_CONFIG_FILE"
+	envarYes            string = "SENZING_TOOLS_YES"
+	ConfigUpgradeShort  string = "Merge the current Senzing configuration template into the default configuration"
+	ConfigUpgradeUse    string = "upgrade"
+)
+
+var ConfigUpgradeLong = `
+Merge the current Senzing configuration template into the default Senzing configuration.
+A three-way merge is done of the template the default configuration was based on (--base-config-file),
+the default configuration, and the template of the installed Senzing.
+Customizations of the default configuration are kept.
+Where the default configuration and the template changed the same value differently,
+the conflict is reported and the value of the default configuration is kept.
+The changes are listed and, after confirmation, the merged configuration becomes the default.
+	`
+
+// Senzing configuration template the default configuration was based on.
+var OptionBaseConfigFile = option.ContextVariable{
+	Arg:     "base-config-file",
+	Default: option.OsLookupEnvString(envarBaseConfigFile, ""),
+	Envar:   envarBaseConfigFile,
+	Help:    "Path to the Senzing configuration template the default configuration was based on [%s]",
+	Type:    optiontype.String,
+}
+
+// Skip confirmation.
+var OptionYes = option.ContextVariable{
+	Arg:     "yes",
+	Default: option.OsLookupEnvBool(envarYes, false),
+	Envar:   envarYes,
+	Help:    "Make the change without asking for confirmation [%s]",
+	Type:    optiontype.Bool,
+}
+
+var ContextVariablesForConfigUpgrade = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		OptionBaseConfigFile,
+		OptionConfigLabel,
+		OptionYes,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ConfigUpgradeCmd represents the "config upgrade" command.
+var ConfigUpgradeCmd = &cobra.Command{
+	Use:          ConfigUpgradeUse,
+	Short:        ConfigUpgradeShort,
+	Long:         ConfigUpgradeLong,
+	PreRun:       ConfigUpgradePreRun,
+	RunE:         ConfigUpgradeRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func ConfigUpgradePreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForConfigUpgrade)
+}
+
+// Used in construction of cobra.Command.
+func ConfigUpgradeRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	baseConfigFile := viper.GetString(OptionBaseConfigFile.Arg)
+	if len(baseConfigFile) == 0 {
+		return wraperror.Errorf(errForPackage, "--%s is required", OptionBaseConfigFile.Arg)
+	}
+
+	baseConfigDefinition, err := os.ReadFile(filepath.Clean(baseConfigFile))
+	if err != nil {
+		return wraperror.Errorf(err, "os.ReadFile: %s", baseConfigFile)
+	}
+
+	senzingConfig, err := getSenzingConfig(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getSenzingConfig")
+	}
+
+	isYes := viper.GetBool(OptionYes.Arg)
+
+	configUpgradePlan, err := senzingConfig.UpgradeConfig(
+		ctx,
+		string(baseConfigDefinition),
+		func(configUpgradePlan senzingconfig.ConfigUpgradePlan) bool {
+			err := ConfigUpgradeAction(os.Stdout, configUpgradePlan)
+			if err != nil {
+				return false
+			}
+
+			return isYes || ConfigUpgradeConfirm(os.Stdin, os.Stdout)
+		},
+	)
+	if err != nil {
+		return wraperror.Errorf(err, "UpgradeConfig: %s", baseConfigFile)
+	}
+
+	switch {
+	case len(configUpgradePlan.Changes) == 0:
+		_, err = fmt.Fprintf(os.Stdout, "Senzing configuration %d is up to date.\n", configUpgradePlan.CurrentConfigID)
+	case configUpgradePlan.NewConfigID == 0:
+		_, err = fmt.Fprintln(os.Stdout, "Upgrade cancelled.")
+	default:
+		_, err = fmt.Fprintf(os.Stdout, "New default Senzing configuration: %d\n", configUpgradePlan.NewConfigID)
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ConfigUpgradeAction writes the changes and conflicts of a configuration upgrade.
+func ConfigUpgradeAction(out io.Writer, configUpgradePlan senzingconfig.ConfigUpgradePlan) error {
+	var builder strings.Builder
+
+	_, _ = fmt.Fprintf(
+		&builder,
+		"Upgrade of Senzing configuration %d: %d change(s), %d conflict(s)\n",
+		configUpgradePlan.CurrentConfigID,
+		len(configUpgradePlan.Changes),
+		len(configUpgradePlan.Conflicts),
+	)
+
+	for _, change := range configUpgradePlan.Changes {
+		_, _ = fmt.Fprintf(&builder, "  %s\n", change)
+	}
+
+	if len(configUpgradePlan.Conflicts) > 0 {
+		_, _ = fmt.Fprintln(&builder, "Conflicts (current value kept):")
+	}
+
+	for _, conflict := range configUpgradePlan.Conflicts {
+		_, _ = fmt.Fprintf(
+			&builder,
+			"  %s\n    current:  %s\n    template: %s\n",
+			strings.TrimSpace(strings.Join([]string{conflict.Section, conflict.Key, conflict.Field}, " ")),
+			describeConfigValue(conflict.CurrentValue),
+			describeConfigValue(conflict.TemplateValue),
+		)
+	}
+
+	_, err := io.WriteString(out, builder.String())
+
+	return wraperror.Errorf(err, "writing config upgrade")
+}
+
+// ConfigUpgradeConfirm asks whether to make the merged configuration the default.  Only "y" or "yes" confirms.
+func ConfigUpgradeConfirm(in io.Reader, out io.Writer) bool {
+	_, _ = fmt.Fprint(out, "Make the merged configuration the default? [y/N] ")
+
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+
+	return slices.Contains([]string{"y", "yes"}, strings.ToLower(strings.TrimSpace(answer)))
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	ConfigCmd.AddCommand(ConfigUpgradeCmd)
+	cmdhelper.Init(ConfigUpgradeCmd, ContextVariablesForConfigUpgrade)
+}
+
+// Describe a configuration value on one line.
+func describeConfigValue(value any) string {
+	if value == nil {
+		return "(none)"
+	}
+
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(result)
+}
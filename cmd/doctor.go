@@ -0,0 +1,155 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/settings"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingdoctor"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	DoctorShort string = "Diagnose the environment used to initialize Senzing databases"
+	DoctorUse   string = "doctor"
+)
+
+var DoctorLong = `
+Diagnose the environment used to initialize Senzing databases.
+Checks Senzing settings, resource files, database connectivity and privileges,
+the Senzing library and license, and the Observer URL.
+Each check is reported as pass, warn, or fail.
+If any check fails, the command exits with a non-zero exit code.
+	`
+
+var ContextVariablesForDoctor = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		option.JSONOutput,
+		OptionEngineConfigurationFile,
+		OptionSQLFile,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// DoctorCmd represents the doctor command.
+var DoctorCmd = &cobra.Command{
+	Use:          DoctorUse,
+	Short:        DoctorShort,
+	Long:         DoctorLong,
+	PreRun:       DoctorPreRun,
+	RunE:         DoctorRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func DoctorPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForDoctor)
+}
+
+// Used in construction of cobra.Command.
+func DoctorRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	// Settings are not verified here; verification is one of the checks.
+
+	senzingSettings, err := settings.BuildSettings(ctx, viper.GetViper())
+	if err != nil {
+		return wraperror.Errorf(err, "BuildSettings")
+	}
+
+	// An error determining the databases is reported by the "database" check.
+
+	databaseURLs, errDatabaseURLs := getDatabaseURLs(ctx, senzingSettings)
+
+	senzingDoctor := &senzingdoctor.BasicSenzingDoctor{
+		DatabaseURLs:          databaseURLs,
+		DatabaseURLsError:     errDatabaseURLs,
+		ObserverURL:           viper.GetString(option.ObserverURL.Arg),
+		SenzingConfigJSONFile: viper.GetString(OptionEngineConfigurationFile.Arg),
+		SenzingInstanceName:   viper.GetString(option.CoreInstanceName.Arg),
+		SenzingSettings:       senzingSettings,
+		SenzingVerboseLogging: viper.GetInt64(option.CoreLogLevel.Arg),
+		SQLFile:               viper.GetString(OptionSQLFile.Arg),
+	}
+
+	checkResults, err := senzingDoctor.Diagnose(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "Diagnose")
+	}
+
+	return DoctorAction(os.Stdout, checkResults, viper.GetBool(option.JSONOutput.Arg))
+}
+
+// DoctorAction writes the results of the checks as a table or as JSON.
+// An error is returned if any check failed.
+func DoctorAction(out io.Writer, checkResults []senzingdoctor.CheckResult, isJSON bool) error {
+	if isJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(checkResults); err != nil {
+			return wraperror.Errorf(err, "encoding check results")
+		}
+	} else {
+		tabWriter := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0) //nolint:mnd
+		_, _ = fmt.Fprintln(tabWriter, "CHECK\tTARGET\tSTATUS\tDETAIL")
+
+		for _, checkResult := range checkResults {
+			_, _ = fmt.Fprintf(
+				tabWriter,
+				"%s\t%s\t%s\t%s\n",
+				checkResult.Check,
+				checkResult.Target,
+				checkResult.Status,
+				checkResult.Detail,
+			)
+		}
+
+		if err := tabWriter.Flush(); err != nil {
+			return wraperror.Errorf(err, "printing check results")
+		}
+	}
+
+	failures := 0
+
+	for _, checkResult := range checkResults {
+		if checkResult.Status == senzingdoctor.StatusFail {
+			failures++
+		}
+	}
+
+	if failures > 0 {
+		return wraperror.Errorf(errForPackage, "%d of %d checks failed", failures, len(checkResults))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	RootCmd.AddCommand(DoctorCmd)
+	cmdhelper.Init(DoctorCmd, ContextVariablesForDoctor)
+}
@@ -0,0 +1,110 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"slices"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+	"github.com/senzing-garage/go-cmdhelping/settings"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingstatus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	envarSelfTest    string = "SENZING_TOOLS_SELF_TEST"
+	HealthcheckShort string = "Verify that Senzing databases are ready for use"
+	HealthcheckUse   string = "healthcheck"
+)
+
+var HealthcheckLong = `
+Verify that Senzing databases are ready for use.
+Every database must have a complete Senzing schema, a default Senzing configuration must exist,
+and each datasource given by --datasources must be registered.
+With --self-test, a test record is also added to Senzing and then deleted.
+Exit codes follow Docker HEALTHCHECK conventions: 0 if healthy, 1 if unhealthy.
+	`
+
+// Add and delete a test record as part of the health check.
+var OptionSelfTest = option.ContextVariable{
+	Arg:     "self-test",
+	Default: option.OsLookupEnvBool(envarSelfTest, false),
+	Envar:   envarSelfTest,
+	Help:    "Add, then delete, a test record when checking health [%s]",
+	Type:    optiontype.Bool,
+}
+
+var ContextVariablesForHealthcheck = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		OptionSelfTest,
+		OptionSQLFile,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// HealthcheckCmd represents the healthcheck command.
+var HealthcheckCmd = &cobra.Command{
+	Use:          HealthcheckUse,
+	Short:        HealthcheckShort,
+	Long:         HealthcheckLong,
+	PreRun:       HealthcheckPreRun,
+	RunE:         HealthcheckRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func HealthcheckPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForHealthcheck)
+}
+
+// Used in construction of cobra.Command.
+func HealthcheckRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	senzingSettings, err := settings.BuildAndVerifySettings(ctx, viper.GetViper())
+	if err != nil {
+		return wraperror.Errorf(err, "BuildAndVerifySettings")
+	}
+
+	databaseURLs, err := getDatabaseURLs(ctx, senzingSettings)
+	if err != nil {
+		return wraperror.Errorf(err, "getDatabaseURLs")
+	}
+
+	senzingStatus := &senzingstatus.BasicSenzingStatus{
+		DatabaseURLs:          databaseURLs,
+		DataSources:           viper.GetStringSlice(option.Datasources.Arg),
+		SelfTest:              viper.GetBool(OptionSelfTest.Arg),
+		SenzingInstanceName:   viper.GetString(option.CoreInstanceName.Arg),
+		SenzingSettings:       senzingSettings,
+		SenzingVerboseLogging: viper.GetInt64(option.CoreLogLevel.Arg),
+		SQLFile:               viper.GetString(OptionSQLFile.Arg),
+	}
+
+	err = senzingStatus.CheckHealth(ctx)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	RootCmd.AddCommand(HealthcheckCmd)
+	cmdhelper.Init(HealthcheckCmd, ContextVariablesForHealthcheck)
+}
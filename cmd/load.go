@@ -0,0 +1,63 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/init-database/initializer"
+	"github.com/spf13/cobra"
+)
+
+const (
+	LoadShort string = "Load data into Senzing"
+	LoadUse   string = "load"
+)
+
+var LoadLong = `
+Load data into Senzing, without creating the Senzing schema or changing the Senzing configuration.
+The Senzing schema and a default Senzing configuration must already exist.
+Records are loaded from the Senzing TruthSet (--load-truthset) and from JSON lines
+given by --load-file (files, directories, or glob patterns) and --load-url (http://, https://, or file:// URLs),
+each of which can be repeated.
+The datasources of the records must already be registered.
+Records are added by --number-of-workers concurrent workers, and throughput is reported when loading ends.
+	`
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// LoadCmd represents the load command.
+var LoadCmd = &cobra.Command{
+	Use:    LoadUse,
+	Short:  LoadShort,
+	Long:   LoadLong,
+	PreRun: LoadPreRun,
+	RunE:   LoadRunE,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func LoadPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForPhases)
+}
+
+// Used in construction of cobra.Command.
+func LoadRunE(_ *cobra.Command, _ []string) error {
+	return runPhases(context.Background(), initializer.PhaseLoad)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	RootCmd.AddCommand(LoadCmd)
+	cmdhelper.Init(LoadCmd, ContextVariablesForPhases)
+}
@@ -4,6 +4,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"slices"
@@ -18,14 +19,28 @@ import (
 	"github.com/senzing-garage/go-helpers/settingsparser"
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/init-database/initializer"
+	"github.com/senzing-garage/init-database/senzingconfig"
+	"github.com/senzing-garage/init-database/senzingload"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 const (
+	envarConfigCommentTemplate         string = "SENZING_TOOLS_CONFIG_COMMENT_TEMPLATE"
+	envarConfigLabel                   string = "SENZING_TOOLS_CONFIG_LABEL"
+	envarConfigSource                  string = "SENZING_TOOLS_CONFIG_SOURCE"
+	envarConfigSourceMode              string = "SENZING_TOOLS_CONFIG_SOURCE_MODE"
+	envarConfigSpecFile                string = "SENZING_TOOLS_CONFIG_SPEC_FILE"
+	envarDatasourcesFile               string = "SENZING_TOOLS_DATASOURCES_FILE"
 	envarEngineConfigurationFile              = "SENZING_TOOLS_ENGINE_CONFIGURATION_FILE"
+	envarForce                         string = "SENZING_TOOLS_FORCE"
 	envarInstallSenzingErConfiguration string = "SENZING_TOOLS_INSTALL_SENZING_ER_CONFIGURATION"
+	envarLoadFile                      string = "SENZING_TOOLS_LOAD_FILE"
 	envarLoadTruthset                  string = "SENZING_TOOLS_LOAD_TRUTHSET"
+	envarLoadTruthsetRemote            string = "SENZING_TOOLS_LOAD_TRUTHSET_REMOTE"
+	envarLoadURL                       string = "SENZING_TOOLS_LOAD_URL"
+	envarPrescanDatasources            string = "SENZING_TOOLS_PRESCAN_DATASOURCES"
+	envarRemoveDatasources             string = "SENZING_TOOLS_REMOVE_DATASOURCES"
 	envarSQLFile                       string = "SENZING_TOOLS_SQL_FILE"
 	Short                              string = "Initialize a database with the Senzing schema and configuration"
 	Use                                string = "init-database"
@@ -33,11 +48,13 @@ const (
 
 var Long = getLong()
 
+var errForPackage = errors.New("cmd")
+
 // ----------------------------------------------------------------------------
 // Context variables
 // ----------------------------------------------------------------------------
 
-// Load the TruthSet data found at https://github.com/Senzing/truth-sets.
+// Load the TruthSet data found at https://github.com/Senzing/truth-sets, using the copy embedded in the binary.
 var OptionLoadTruthset = option.ContextVariable{
 	Arg:     "load-truthset",
 	Default: option.OsLookupEnvBool(envarLoadTruthset, false),
@@ -46,6 +63,54 @@ var OptionLoadTruthset = option.ContextVariable{
 	Type:    optiontype.Bool,
 }
 
+var OptionConfigCommentTemplate = option.ContextVariable{
+	Arg:     "config-comment-template",
+	Default: option.OsLookupEnvString(envarConfigCommentTemplate, ""),
+	Envar:   envarConfigCommentTemplate,
+	Help:    "Comment of new Senzing configurations, with placeholders {operation}, {time}, {datasources}, {host}, {version}, and {KEY} [%s]",
+	Type:    optiontype.String,
+}
+
+var OptionConfigLabel = option.ContextVariable{
+	Arg:     "config-label",
+	Default: []string{},
+	Envar:   envarConfigLabel,
+	Help:    "Labels, as key=value, stored with new Senzing configurations and shown by config history and export [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var OptionConfigSource = option.ContextVariable{
+	Arg:     "config-source",
+	Default: option.OsLookupEnvString(envarConfigSource, ""),
+	Envar:   envarConfigSource,
+	Help:    "Database URL or Senzing settings JSON of a repository whose default configuration is installed [%s]",
+	Type:    optiontype.String,
+}
+
+var OptionConfigSourceMode = option.ContextVariable{
+	Arg:     "config-source-mode",
+	Default: option.OsLookupEnvString(envarConfigSourceMode, senzingconfig.ConfigSourceModeClone),
+	Envar:   envarConfigSourceMode,
+	Help:    "With config-source, either clone its configuration or merge in its datasources [%s]",
+	Type:    optiontype.String,
+}
+
+var OptionConfigSpecFile = option.ContextVariable{
+	Arg:     "config-spec-file",
+	Default: option.OsLookupEnvString(envarConfigSpecFile, ""),
+	Envar:   envarConfigSpecFile,
+	Help:    "Path to YAML or JSON file declaring the desired Senzing configuration [%s]",
+	Type:    optiontype.String,
+}
+
+var OptionDatasourcesFile = option.ContextVariable{
+	Arg:     "datasources-file",
+	Default: option.OsLookupEnvString(envarDatasourcesFile, ""),
+	Envar:   envarDatasourcesFile,
+	Help:    "Path to file of datasources, one per line or a JSON/YAML array, added to datasources [%s]",
+	Type:    optiontype.String,
+}
+
 var OptionEngineConfigurationFile = option.ContextVariable{
 	Arg:     "engine-configuration-file",
 	Default: getEngineConfigurationFileDefault(),
@@ -54,6 +119,55 @@ var OptionEngineConfigurationFile = option.ContextVariable{
 	Type:    optiontype.String,
 }
 
+var OptionForce = option.ContextVariable{
+	Arg:     "force",
+	Default: option.OsLookupEnvBool(envarForce, false),
+	Envar:   envarForce,
+	Help:    "Remove datasources even if they still have records [%s]",
+	Type:    optiontype.Bool,
+}
+
+var OptionLoadFile = option.ContextVariable{
+	Arg:     "load-file",
+	Default: []string{},
+	Envar:   envarLoadFile,
+	Help:    "Files, directories, or glob patterns of JSON lines records to load [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+// Download the TruthSet data from https://github.com/Senzing/truth-sets instead of using the embedded copy.
+var OptionLoadTruthsetRemote = option.ContextVariable{
+	Arg:     "load-truthset-remote",
+	Default: option.OsLookupEnvBool(envarLoadTruthsetRemote, false),
+	Envar:   envarLoadTruthsetRemote,
+	Help:    "With --load-truthset, download the Senzing TruthSet files instead of using the copies in the binary [%s]",
+	Type:    optiontype.Bool,
+}
+
+var OptionLoadURL = option.ContextVariable{
+	Arg:     "load-url",
+	Default: []string{},
+	Envar:   envarLoadURL,
+	Help:    "http://, https://, or file:// URLs of JSON lines records to load [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var OptionPrescanDatasources = option.ContextVariable{
+	Arg:     "prescan-datasources",
+	Default: option.OsLookupEnvBool(envarPrescanDatasources, false),
+	Envar:   envarPrescanDatasources,
+	Help:    "Before loading, register the datasources found in the records to be loaded [%s]",
+	Type:    optiontype.Bool,
+}
+
+var OptionRemoveDatasources = option.ContextVariable{
+	Arg:     "remove-datasources",
+	Default: []string{},
+	Envar:   envarRemoveDatasources,
+	Help:    "Datasources to remove from the Senzing configuration [%s]",
+	Type:    optiontype.StringSlice,
+}
+
 var OptionSQLFile = option.ContextVariable{
 	Arg:     "sql-file",
 	Default: getSQLFileDefault(),
@@ -87,6 +201,28 @@ var ContextVariablesForMultiPlatform = []option.ContextVariable{
 
 var ContextVariables = append(ContextVariablesForMultiPlatform, ContextVariablesForOsArch...)
 
+// Context variables for the root command and the commands that run individual phases.
+var ContextVariablesForPhases = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		option.NumberOfWorkers,
+		OptionConfigCommentTemplate,
+		OptionConfigLabel,
+		OptionConfigSource,
+		OptionConfigSourceMode,
+		OptionConfigSpecFile,
+		OptionDatasourcesFile,
+		OptionEngineConfigurationFile,
+		OptionForce,
+		OptionLoadFile,
+		OptionLoadTruthsetRemote,
+		OptionLoadURL,
+		OptionPrescanDatasources,
+		OptionRemoveDatasources,
+		OptionSQLFile,
+	},
+)
+
 // ----------------------------------------------------------------------------
 // Command
 // ----------------------------------------------------------------------------
@@ -116,43 +252,12 @@ func Execute() {
 
 // Used in construction of cobra.Command.
 func PreRun(cobraCommand *cobra.Command, args []string) {
-	cmdhelper.PreRun(cobraCommand, args, Use, append(ContextVariables, OptionSQLFile, OptionEngineConfigurationFile))
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForPhases)
 }
 
 // Used in construction of cobra.Command.
 func RunE(_ *cobra.Command, _ []string) error {
-	var err error
-
-	ctx := context.Background()
-
-	senzingSettings, err := settings.BuildAndVerifySettings(ctx, viper.GetViper())
-	if err != nil {
-		return wraperror.Errorf(err, "BuildAndVerifySettings")
-	}
-
-	databaseURLs, err := getDatabaseURLs(ctx, senzingSettings)
-	if err != nil {
-		return wraperror.Errorf(err, "getDatabaseURLs")
-	}
-
-	initializer := &initializer.BasicInitializer{
-		DatabaseURLs:                databaseURLs,
-		DataSources:                 viper.GetStringSlice(option.Datasources.Arg),
-		InstallSenzingConfiguration: viper.GetBool(OptionInstallSenzingErConfiguration.Arg),
-		LoadTruthset:                viper.GetBool(OptionLoadTruthset.Arg),
-		ObserverOrigin:              viper.GetString(option.ObserverOrigin.Arg),
-		ObserverURL:                 viper.GetString(option.ObserverURL.Arg),
-		SenzingInstanceName:         viper.GetString(option.CoreInstanceName.Arg),
-		SenzingLogLevel:             viper.GetString(option.LogLevel.Arg),
-		SenzingSettings:             senzingSettings,
-		SenzingSettingsFile:         viper.GetString(OptionEngineConfigurationFile.Arg),
-		SenzingVerboseLogging:       viper.GetInt64(option.CoreLogLevel.Arg),
-		SQLFile:                     viper.GetString(OptionSQLFile.Arg),
-	}
-
-	err = initializer.Initialize(ctx)
-
-	return wraperror.Errorf(err, wraperror.NoMessage)
+	return runPhases(context.Background())
 }
 
 // Used in construction of cobra.Command.
@@ -210,6 +315,67 @@ func getDatabaseURLs(ctx context.Context, senzingSettings string) ([]string, err
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+// Create an initializer from the command line parameters.
+func getInitializer(ctx context.Context) (*initializer.BasicInitializer, error) {
+	senzingSettings, err := settings.BuildAndVerifySettings(ctx, viper.GetViper())
+	if err != nil {
+		return nil, wraperror.Errorf(err, "BuildAndVerifySettings")
+	}
+
+	databaseURLs, err := getDatabaseURLs(ctx, senzingSettings)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "getDatabaseURLs")
+	}
+
+	configLabels, err := senzingconfig.ParseConfigLabels(viper.GetStringSlice(OptionConfigLabel.Arg))
+	if err != nil {
+		return nil, wraperror.Errorf(err, "ParseConfigLabels")
+	}
+
+	loadFiles := viper.GetStringSlice(OptionLoadFile.Arg)
+
+	err = senzingload.ValidateLoadFiles(loadFiles)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "--%s", OptionLoadFile.Arg)
+	}
+
+	loadURLs := viper.GetStringSlice(OptionLoadURL.Arg)
+
+	err = senzingload.ValidateLoadURLs(loadURLs)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "--%s", OptionLoadURL.Arg)
+	}
+
+	result := &initializer.BasicInitializer{
+		ConfigCommentTemplate:       viper.GetString(OptionConfigCommentTemplate.Arg),
+		ConfigLabels:                configLabels,
+		ConfigSource:                viper.GetString(OptionConfigSource.Arg),
+		ConfigSourceMode:            viper.GetString(OptionConfigSourceMode.Arg),
+		ConfigSpecFile:              viper.GetString(OptionConfigSpecFile.Arg),
+		DatabaseURLs:                databaseURLs,
+		DataSources:                 viper.GetStringSlice(option.Datasources.Arg),
+		DataSourcesFile:             viper.GetString(OptionDatasourcesFile.Arg),
+		Force:                       viper.GetBool(OptionForce.Arg),
+		InstallSenzingConfiguration: viper.GetBool(OptionInstallSenzingErConfiguration.Arg),
+		LoadTruthset:                viper.GetBool(OptionLoadTruthset.Arg),
+		LoadTruthsetRemote:          viper.GetBool(OptionLoadTruthsetRemote.Arg),
+		LoadURLs:                    slices.Concat(loadFiles, loadURLs),
+		NumberOfWorkers:             viper.GetInt(option.NumberOfWorkers.Arg),
+		ObserverOrigin:              viper.GetString(option.ObserverOrigin.Arg),
+		ObserverURL:                 viper.GetString(option.ObserverURL.Arg),
+		PrescanDataSources:          viper.GetBool(OptionPrescanDatasources.Arg),
+		RemoveDataSources:           viper.GetStringSlice(OptionRemoveDatasources.Arg),
+		SenzingInstanceName:         viper.GetString(option.CoreInstanceName.Arg),
+		SenzingLogLevel:             viper.GetString(option.LogLevel.Arg),
+		SenzingSettings:             senzingSettings,
+		SenzingSettingsFile:         viper.GetString(OptionEngineConfigurationFile.Arg),
+		SenzingVerboseLogging:       viper.GetInt64(option.CoreLogLevel.Arg),
+		SQLFile:                     viper.GetString(OptionSQLFile.Arg),
+	}
+
+	return result, nil
+}
+
 // Construct the path to the "g2config.json" file.
 func getEngineConfigurationFileDefault() string {
 	var result string
@@ -368,7 +534,21 @@ func getSQLFileDefault() string {
 	return result
 }
 
+// Initialize Senzing databases.  If no phases are given, all phases are run.
+func runPhases(ctx context.Context, phases ...string) error {
+	initializer, err := getInitializer(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getInitializer")
+	}
+
+	initializer.Phases = phases
+
+	err = initializer.Initialize(ctx)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 // Since init() is always invoked, define command line parameters.
 func init() {
-	cmdhelper.Init(RootCmd, append(ContextVariables, OptionSQLFile, OptionEngineConfigurationFile))
+	cmdhelper.Init(RootCmd, ContextVariablesForPhases)
 }
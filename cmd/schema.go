@@ -0,0 +1,57 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/init-database/initializer"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SchemaShort string = "Create the Senzing schema in databases"
+	SchemaUse   string = "schema"
+)
+
+var SchemaLong = `
+Create the Senzing schema in databases, without installing a Senzing configuration or loading data.
+	`
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// SchemaCmd represents the schema command.
+var SchemaCmd = &cobra.Command{
+	Use:    SchemaUse,
+	Short:  SchemaShort,
+	Long:   SchemaLong,
+	PreRun: SchemaPreRun,
+	RunE:   SchemaRunE,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func SchemaPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForPhases)
+}
+
+// Used in construction of cobra.Command.
+func SchemaRunE(_ *cobra.Command, _ []string) error {
+	return runPhases(context.Background(), initializer.PhaseSchema)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	RootCmd.AddCommand(SchemaCmd)
+	cmdhelper.Init(SchemaCmd, ContextVariablesForPhases)
+}
@@ -0,0 +1,120 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/settings"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/init-database/senzingstatus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	StatusShort string = "Report the initialization state of each Senzing database"
+	StatusUse   string = "status"
+)
+
+var StatusLong = `
+Report the initialization state of each Senzing database as JSON.
+For each database: whether it is reachable, whether the Senzing schema is installed and complete,
+the default Senzing configuration ID, registered datasources, record counts per datasource,
+and the number of pending redo records.
+	`
+
+var ContextVariablesForStatus = slices.Concat(
+	ContextVariables,
+	[]option.ContextVariable{
+		OptionSQLFile,
+	},
+)
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// StatusCmd represents the status command.
+var StatusCmd = &cobra.Command{
+	Use:          StatusUse,
+	Short:        StatusShort,
+	Long:         StatusLong,
+	PreRun:       StatusPreRun,
+	RunE:         StatusRunE,
+	SilenceUsage: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Used in construction of cobra.Command.
+func StatusPreRun(cobraCommand *cobra.Command, args []string) {
+	cmdhelper.PreRun(cobraCommand, args, Use, ContextVariablesForStatus)
+}
+
+// Used in construction of cobra.Command.
+func StatusRunE(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	databaseStatuses, err := getDatabaseStatuses(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getDatabaseStatuses")
+	}
+
+	return StatusAction(os.Stdout, databaseStatuses)
+}
+
+// StatusAction writes the status of each database as JSON.
+func StatusAction(out io.Writer, databaseStatuses []senzingstatus.DatabaseStatus) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(databaseStatuses); err != nil {
+		return wraperror.Errorf(err, "StatusAction")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Get the status of each database from the command line parameters.
+func getDatabaseStatuses(ctx context.Context) ([]senzingstatus.DatabaseStatus, error) {
+	senzingSettings, err := settings.BuildAndVerifySettings(ctx, viper.GetViper())
+	if err != nil {
+		return nil, wraperror.Errorf(err, "BuildAndVerifySettings")
+	}
+
+	databaseURLs, err := getDatabaseURLs(ctx, senzingSettings)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "getDatabaseURLs")
+	}
+
+	senzingStatus := &senzingstatus.BasicSenzingStatus{
+		DatabaseURLs:          databaseURLs,
+		SenzingInstanceName:   viper.GetString(option.CoreInstanceName.Arg),
+		SenzingSettings:       senzingSettings,
+		SenzingVerboseLogging: viper.GetInt64(option.CoreLogLevel.Arg),
+		SQLFile:               viper.GetString(OptionSQLFile.Arg),
+	}
+
+	result, err := senzingStatus.GetStatus(ctx)
+
+	return result, wraperror.Errorf(err, "GetStatus")
+}
+
+// Since init() is always invoked, define command line parameters.
+func init() {
+	RootCmd.AddCommand(StatusCmd)
+	cmdhelper.Init(StatusCmd, ContextVariablesForStatus)
+}
@@ -3,6 +3,11 @@ module github.com/senzing-garage/init-database
 go 1.26.0
 
 require (
+	github.com/go-sql-driver/mysql v1.10.0
+	github.com/godror/godror v0.50.0
+	github.com/lib/pq v1.12.3
+	github.com/mattn/go-sqlite3 v1.14.45
+	github.com/microsoft/go-mssqldb v1.10.0
 	github.com/senzing-garage/go-cmdhelping v0.3.8
 	github.com/senzing-garage/go-databasing v0.5.9
 	github.com/senzing-garage/go-helpers v0.6.16
@@ -10,9 +15,11 @@ require (
 	github.com/senzing-garage/go-observing v0.3.7
 	github.com/senzing-garage/go-sdk-abstract-factory v0.9.17
 	github.com/senzing-garage/sz-sdk-go v0.15.14
+	github.com/senzing-garage/sz-sdk-go-mock v0.8.14
 	github.com/spf13/cobra v1.10.2
 	github.com/spf13/viper v1.21.0
 	github.com/stretchr/testify v1.12.0
+	go.yaml.in/yaml/v3 v3.0.4
 	google.golang.org/grpc v1.83.0
 )
 
@@ -22,31 +29,24 @@ require (
 	github.com/cpuguy83/go-md2man/v2 v2.0.7 // indirect
 	github.com/fsnotify/fsnotify v1.10.1 // indirect
 	github.com/go-logfmt/logfmt v0.6.1 // indirect
-	github.com/go-sql-driver/mysql v1.10.0 // indirect
 	github.com/go-viper/mapstructure/v2 v2.5.0 // indirect
-	github.com/godror/godror v0.50.0 // indirect
 	github.com/godror/knownpb v0.3.0 // indirect
 	github.com/golang-sql/civil v0.0.0-20220223132316-b832511892a9 // indirect
 	github.com/golang-sql/sqlexp v0.1.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
-	github.com/lib/pq v1.12.3 // indirect
-	github.com/mattn/go-sqlite3 v1.14.45 // indirect
-	github.com/microsoft/go-mssqldb v1.10.0 // indirect
 	github.com/pelletier/go-toml/v2 v2.4.0 // indirect
 	github.com/russross/blackfriday/v2 v2.1.0 // indirect
 	github.com/sagikazarmark/locafero v0.12.0 // indirect
 	github.com/senzing-garage/go-messaging v1.5.3 // indirect
 	github.com/senzing-garage/sz-sdk-go-core v0.9.14 // indirect
 	github.com/senzing-garage/sz-sdk-go-grpc v0.9.12 // indirect
-	github.com/senzing-garage/sz-sdk-go-mock v0.8.14 // indirect
 	github.com/senzing-garage/sz-sdk-proto v0.8.8 // indirect
 	github.com/shopspring/decimal v1.4.0 // indirect
 	github.com/spf13/afero v1.15.0 // indirect
 	github.com/spf13/cast v1.10.0 // indirect
 	github.com/spf13/pflag v1.0.10 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
-	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	golang.org/x/crypto v0.53.0 // indirect
 	golang.org/x/exp v0.0.0-20260611194520-c48552f49976 // indirect
 	golang.org/x/net v0.56.0 // indirect
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/senzing-garage/init-database/senzingconfig"
 	"github.com/senzing-garage/init-database/senzingload"
 	"github.com/senzing-garage/init-database/senzingschema"
+	"github.com/senzing-garage/init-database/senzingstatus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -30,17 +32,30 @@ import (
 
 // BasicInitializer is the default implementation of the Initializer interface.
 type BasicInitializer struct {
-	DatabaseURLs                []string `json:"databaseUrl,omitempty"`
-	DataSources                 []string `json:"dataSources,omitempty"`
-	InstallSenzingConfiguration bool     `json:"installSenzingConfiguration,omitempty"`
-	LoadTruthset                bool     `json:"loadTruthset,omitempty"`
+	ConfigCommentTemplate       string            `json:"configCommentTemplate,omitempty"`
+	ConfigLabels                map[string]string `json:"configLabels,omitempty"`
+	ConfigSource                string            `json:"configSource,omitempty"`
+	ConfigSourceMode            string            `json:"configSourceMode,omitempty"`
+	ConfigSpecFile              string            `json:"configSpecFile,omitempty"`
+	DatabaseURLs                []string          `json:"databaseUrl,omitempty"`
+	DataSources                 []string          `json:"dataSources,omitempty"`
+	DataSourcesFile             string            `json:"dataSourcesFile,omitempty"`
+	Force                       bool              `json:"force,omitempty"`
+	InstallSenzingConfiguration bool              `json:"installSenzingConfiguration,omitempty"`
+	LoadTruthset                bool              `json:"loadTruthset,omitempty"`
+	LoadTruthsetRemote          bool              `json:"loadTruthsetRemote,omitempty"`
+	LoadURLs                    []string          `json:"loadUrls,omitempty"`
 	logger                      logging.Logging
 	mutexConfigSingleton        sync.Mutex
 	mutexLoadSingleton          sync.Mutex
 	mutexSchemaSingleton        sync.Mutex
+	NumberOfWorkers             int    `json:"numberOfWorkers,omitempty"`
 	ObserverOrigin              string `json:"observerOrigin,omitempty"`
 	observers                   subject.Subject
-	ObserverURL                 string `json:"observerUrl,omitempty"`
+	ObserverURL                 string   `json:"observerUrl,omitempty"`
+	Phases                      []string `json:"phases,omitempty"`
+	PrescanDataSources          bool     `json:"prescanDataSources,omitempty"`
+	RemoveDataSources           []string `json:"removeDataSources,omitempty"`
 	senzingConfigSingleton      senzingconfig.SenzingConfig
 	SenzingInstanceName         string `json:"senzingInstanceName,omitempty"`
 	senzingLoadSingleton        senzingload.SenzingLoad
@@ -70,7 +85,7 @@ var truthsetDataSources = []string{
 	"WATCHLIST",
 }
 
-// Hard-coded location of "raw" TruthSet JSON lines files.
+// Hard-coded location of "raw" TruthSet JSON lines files, used instead of the embedded copies if LoadTruthsetRemote.
 var truthsetURLs = []string{
 	"https://raw.githubusercontent.com/Senzing/truth-sets/refs/heads/main/truthsets/demo/customers.jsonl",
 	"https://raw.githubusercontent.com/Senzing/truth-sets/refs/heads/main/truthsets/demo/reference.jsonl",
@@ -81,9 +96,50 @@ var truthsetURLs = []string{
 // Interface methods
 // ----------------------------------------------------------------------------
 
+/*
+The GetSenzingConfig method returns the SenzingConfig used by the config phase,
+with log level and observers set.
+It is used to manage the Senzing configuration outside of Initialize.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The SenzingConfig.
+*/
+func (initializer *BasicInitializer) GetSenzingConfig(ctx context.Context) (senzingconfig.SenzingConfig, error) {
+	logLevel := initializer.SenzingLogLevel
+	if logLevel == "" {
+		logLevel = "INFO"
+	}
+
+	senzingConfig := initializer.getSenzingConfig()
+
+	err := senzingConfig.SetLogLevel(ctx, logLevel)
+	if err != nil {
+		return senzingConfig, wraperror.Errorf(err, "config.SetLogLevel: %s", logLevel)
+	}
+
+	anObserver, err := initializer.getObserver(ctx)
+	if err != nil {
+		return senzingConfig, wraperror.Errorf(err, "getObserver")
+	}
+
+	err = initializer.registerObserverSenzingConfig(ctx, anObserver)
+	if err != nil {
+		return senzingConfig, wraperror.Errorf(err, "registerObserverSenzingConfig")
+	}
+
+	return senzingConfig, nil
+}
+
 /*
 The Initialize method adds the Senzing database schema and Senzing default configuration to databases.
 Essentially it calls senzingSchema.Initialize() and senzingConfig.Initialize(ctx).
+Before any schema is created, the privileges of the database user are verified.
+If Phases is not empty, only the listed phases are run.
+If PrescanDataSources is set, the datasources found in the records to be loaded are registered
+before loading, even if the config phase is not run.
 
 Input
   - ctx: A context to control lifecycle.
@@ -140,9 +196,70 @@ func (initializer *BasicInitializer) Initialize(ctx context.Context) error {
 
 	anObserver, err := initializer.getObserver(ctx)
 
-	// Verify database file exists.
+	// Verify that requested phases exist and that the phases they depend upon have been done.
+
+	err = initializer.verifyPhases(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 23, 1023
+
+		return wraperror.Errorf(err, "verifyPhases")
+	}
+
+	// Add datasources listed in a file.
+
+	if len(initializer.DataSourcesFile) > 0 {
+		var fileDataSources []string
+
+		fileDataSources, err = senzingconfig.LoadDataSourcesFile(initializer.DataSourcesFile)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 26, 1026
+
+			return wraperror.Errorf(err, "LoadDataSourcesFile: %s", initializer.DataSourcesFile)
+		}
+
+		initializer.DataSources = senzingconfig.MergeDataSources(initializer.DataSources, fileDataSources)
+	}
+
+	// Add datasources found in the records to be loaded.
 
-	if len(initializer.SQLFile) > 0 {
+	var scannedDataSources []string
+
+	if initializer.PrescanDataSources && initializer.hasRecordsToLoad() && initializer.isPhaseSelected(PhaseLoad) {
+		var dataSourceCounts []senzingload.DataSourceCount
+
+		dataSourceCounts, err = initializer.getSenzingLoad().ScanDataSources(ctx)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 28, 1028
+
+			return wraperror.Errorf(err, "ScanDataSources")
+		}
+
+		for _, dataSourceCount := range dataSourceCounts {
+			scannedDataSources = append(scannedDataSources, dataSourceCount.DataSource)
+		}
+
+		initializer.log(2003, scannedDataSources)
+
+		initializer.DataSources = senzingconfig.MergeDataSources(initializer.DataSources, scannedDataSources)
+	}
+
+	// Verify datasource codes before any phase changes the database.
+
+	dataSources := slices.Clone(initializer.DataSources)
+	if initializer.LoadTruthset {
+		dataSources = append(dataSources, truthsetDataSources...)
+	}
+
+	err = senzingconfig.ValidateDataSourceCodes(dataSources)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 27, 1027
+
+		return wraperror.Errorf(err, "ValidateDataSourceCodes")
+	}
+
+	// Verify database file exists.  Only the schema phase uses it.
+
+	if len(initializer.SQLFile) > 0 && initializer.isPhaseSelected(PhaseSchema) {
 		_, err = os.Stat(initializer.SQLFile)
 		if err != nil {
 			initializer.log(3001, initializer.SQLFile)
@@ -153,38 +270,51 @@ func (initializer *BasicInitializer) Initialize(ctx context.Context) error {
 		}
 	}
 
-	// Perform initialization for specific databases.
+	// Schema phase.
 
-	err = initializer.InitializeSpecificDatabase(ctx)
-	if err != nil {
-		traceExitMessageNumber, debugMessageNumber = 12, 1012
+	if initializer.isPhaseSelected(PhaseSchema) {
+		// Perform initialization for specific databases.
 
-		return wraperror.Errorf(err, "InitializeSpecificDatabase")
-	}
+		err = initializer.InitializeSpecificDatabase(ctx)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 12, 1012
 
-	// Create schema in database.
+			return wraperror.Errorf(err, "InitializeSpecificDatabase")
+		}
 
-	senzingSchema := initializer.getSenzingSchema()
+		// Create schema in database.
 
-	err = senzingSchema.SetLogLevel(ctx, logLevel)
-	if err != nil {
-		traceExitMessageNumber, debugMessageNumber = 13, 1013
+		senzingSchema := initializer.getSenzingSchema()
 
-		return wraperror.Errorf(err, "schema.SetLogLevel: %s", logLevel)
-	}
+		err = senzingSchema.SetLogLevel(ctx, logLevel)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 13, 1013
 
-	err = initializer.registerObserverSenzingSchema(ctx, anObserver)
-	if err != nil {
-		traceExitMessageNumber, debugMessageNumber = 19, 1019
+			return wraperror.Errorf(err, "schema.SetLogLevel: %s", logLevel)
+		}
 
-		return wraperror.Errorf(err, "registerObserverSenzingSchema")
-	}
+		err = initializer.registerObserverSenzingSchema(ctx, anObserver)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 19, 1019
 
-	err = senzingSchema.InitializeSenzing(ctx)
-	if err != nil {
-		traceExitMessageNumber, debugMessageNumber = 14, 1014
+			return wraperror.Errorf(err, "registerObserverSenzingSchema")
+		}
+
+		// Verify database privileges before any Senzing DDL is sent.
+
+		err = senzingSchema.CheckPrivileges(ctx)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 22, 1022
+
+			return wraperror.Errorf(err, "CheckPrivileges")
+		}
+
+		err = senzingSchema.InitializeSenzing(ctx)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 14, 1014
 
-		return wraperror.Errorf(err, "InitializeSenzing")
+			return wraperror.Errorf(err, "InitializeSenzing")
+		}
 	}
 
 	// Add Truth Set data sources.
@@ -198,9 +328,15 @@ func (initializer *BasicInitializer) Initialize(ctx context.Context) error {
 		}
 	}
 
-	// Determine if Senzing configuration should be installed
+	// Config phase.  Determine if Senzing configuration should be installed.
 
-	if initializer.InstallSenzingConfiguration || len(initializer.DataSources) > 0 {
+	if initializer.isPhaseSelected(PhaseConfig) &&
+		(initializer.InstallSenzingConfiguration ||
+			len(initializer.DataSources) > 0 ||
+			len(initializer.RemoveDataSources) > 0 ||
+			len(initializer.ConfigSpecFile) > 0 ||
+			len(initializer.ConfigSource) > 0 ||
+			slices.Contains(initializer.Phases, PhaseConfig)) {
 		senzingConfig := initializer.getSenzingConfig()
 
 		err = senzingConfig.SetLogLevel(ctx, logLevel)
@@ -223,18 +359,51 @@ func (initializer *BasicInitializer) Initialize(ctx context.Context) error {
 
 			return wraperror.Errorf(err, "InitializeSenzing")
 		}
-	}
 
-	// Load Truth Set.
+		if len(initializer.RemoveDataSources) > 0 {
+			err = senzingConfig.UnregisterDataSources(ctx, initializer.RemoveDataSources, initializer.Force)
+			if err != nil {
+				traceExitMessageNumber, debugMessageNumber = 25, 1025
 
-	if initializer.LoadTruthset {
-		senzingLoad := initializer.getSenzingLoad()
+				return wraperror.Errorf(err, "UnregisterDataSources")
+			}
+		}
 
-		err := senzingLoad.LoadURLs(ctx)
+		if len(initializer.ConfigSpecFile) > 0 {
+			err = senzingConfig.ApplyConfigSpec(ctx)
+			if err != nil {
+				traceExitMessageNumber, debugMessageNumber = 24, 1024
+
+				return wraperror.Errorf(err, "ApplyConfigSpec: %s", initializer.ConfigSpecFile)
+			}
+		}
+	}
+
+	// Without the config phase, register the datasources found in the records to be loaded.
+
+	if !initializer.isPhaseSelected(PhaseConfig) && len(scannedDataSources) > 0 {
+		err = initializer.registerScannedDataSources(ctx, scannedDataSources, logLevel, anObserver)
 		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 99, 1999
+			traceExitMessageNumber, debugMessageNumber = 30, 1030
+
+			return wraperror.Errorf(err, "registerScannedDataSources")
+		}
+	}
+
+	// Load phase.  Load Truth Set and other records.
+
+	if initializer.isPhaseSelected(PhaseLoad) {
+		if initializer.hasRecordsToLoad() {
+			senzingLoad := initializer.getSenzingLoad()
+
+			err := senzingLoad.LoadURLs(ctx)
+			if err != nil {
+				traceExitMessageNumber, debugMessageNumber = 99, 1999
 
-			return wraperror.Errorf(err, "LoadURLs")
+				return wraperror.Errorf(err, "LoadURLs")
+			}
+		} else if slices.Contains(initializer.Phases, PhaseLoad) {
+			initializer.log(3002)
 		}
 	}
 
@@ -839,6 +1008,11 @@ func (initializer *BasicInitializer) getSenzingConfig() senzingconfig.SenzingCon
 
 	if initializer.senzingConfigSingleton == nil {
 		initializer.senzingConfigSingleton = &senzingconfig.BasicSenzingConfig{
+			ConfigCommentTemplate: initializer.ConfigCommentTemplate,
+			ConfigLabels:          initializer.ConfigLabels,
+			ConfigSource:          initializer.ConfigSource,
+			ConfigSourceMode:      initializer.ConfigSourceMode,
+			ConfigSpecFile:        initializer.ConfigSpecFile,
 			DataSources:           initializer.DataSources,
 			SenzingConfigJSONFile: initializer.SenzingSettingsFile,
 			SenzingSettings:       initializer.SenzingSettings,
@@ -855,8 +1029,19 @@ func (initializer *BasicInitializer) getSenzingLoad() senzingload.SenzingLoad {
 	defer initializer.mutexLoadSingleton.Unlock()
 
 	if initializer.senzingLoadSingleton == nil {
+		jsonURLs := slices.Clone(initializer.LoadURLs)
+		if initializer.LoadTruthset {
+			loadTruthsetURLs := senzingload.EmbeddedTruthsetURLs
+			if initializer.LoadTruthsetRemote {
+				loadTruthsetURLs = truthsetURLs
+			}
+
+			jsonURLs = append(slices.Clone(loadTruthsetURLs), jsonURLs...)
+		}
+
 		initializer.senzingLoadSingleton = &senzingload.BasicSenzingLoad{
-			JSONURLs:              truthsetURLs,
+			JSONURLs:              jsonURLs,
+			NumberOfWorkers:       initializer.NumberOfWorkers,
 			SenzingInstanceName:   initializer.SenzingInstanceName,
 			SenzingSettings:       initializer.SenzingSettings,
 			SenzingVerboseLogging: initializer.SenzingVerboseLogging,
@@ -881,6 +1066,116 @@ func (initializer *BasicInitializer) getSenzingSchema() senzingschema.SenzingSch
 	return initializer.senzingSchemaSingleton
 }
 
+// --- Phases -----------------------------------------------------------------
+
+// Register datasources in the default Senzing configuration, without the rest of the config phase.
+func (initializer *BasicInitializer) registerScannedDataSources(
+	ctx context.Context,
+	dataSources []string,
+	logLevel string,
+	anObserver observer.Observer,
+) error {
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		ConfigCommentTemplate: initializer.ConfigCommentTemplate,
+		ConfigLabels:          initializer.ConfigLabels,
+		DataSources:           dataSources,
+		SenzingSettings:       initializer.SenzingSettings,
+		SenzingInstanceName:   initializer.SenzingInstanceName,
+		SenzingVerboseLogging: initializer.SenzingVerboseLogging,
+	}
+
+	err := senzingConfig.SetLogLevel(ctx, logLevel)
+	if err != nil {
+		return wraperror.Errorf(err, "config.SetLogLevel: %s", logLevel)
+	}
+
+	senzingConfig.SetObserverOrigin(ctx, initializer.ObserverOrigin)
+
+	err = senzingConfig.RegisterObserver(ctx, anObserver)
+	if err != nil {
+		return wraperror.Errorf(err, "config.RegisterObserver")
+	}
+
+	err = senzingConfig.InitializeSenzing(ctx)
+
+	return wraperror.Errorf(err, "config.InitializeSenzing")
+}
+
+// Determine if the load phase has records to load.
+func (initializer *BasicInitializer) hasRecordsToLoad() bool {
+	return initializer.LoadTruthset || len(initializer.LoadURLs) > 0
+}
+
+// Determine if a phase is to be run.  No Phases means all phases are run.
+func (initializer *BasicInitializer) isPhaseSelected(phase string) bool {
+	return len(initializer.Phases) == 0 || slices.Contains(initializer.Phases, phase)
+}
+
+// Verify that Phases are known and that the phases they depend upon, if not also selected,
+// have already been done.
+func (initializer *BasicInitializer) verifyPhases(ctx context.Context) error {
+	if len(initializer.Phases) == 0 {
+		return nil
+	}
+
+	for _, phase := range initializer.Phases {
+		if !slices.Contains(AllPhases, phase) {
+			return wraperror.Errorf(errForPackage, "unknown phase: %s; valid phases: %v", phase, AllPhases)
+		}
+	}
+
+	initializer.log(2002, initializer.Phases)
+
+	// The schema phase depends on nothing.
+
+	if initializer.isPhaseSelected(PhaseSchema) {
+		return nil
+	}
+
+	dataSources := []string{}
+	if initializer.isPhaseSelected(PhaseLoad) && !initializer.isPhaseSelected(PhaseConfig) && initializer.LoadTruthset {
+		dataSources = truthsetDataSources
+	}
+
+	// SQLFile is only used by the schema phase, so the schema is checked against the Senzing SQL file.
+
+	senzingStatus := &senzingstatus.BasicSenzingStatus{
+		DatabaseURLs:          initializer.DatabaseURLs,
+		SenzingInstanceName:   initializer.SenzingInstanceName,
+		SenzingSettings:       initializer.SenzingSettings,
+		SenzingVerboseLogging: initializer.SenzingVerboseLogging,
+	}
+
+	databaseStatuses, err := senzingStatus.GetStatus(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "GetStatus")
+	}
+
+	problems := senzingstatus.EvaluateHealth(databaseStatuses, dataSources)
+
+	if initializer.isPhaseSelected(PhaseConfig) {
+		// Only the schema is needed, so ignore problems with the Senzing configuration.
+		problems = []string{}
+
+		for _, databaseStatus := range databaseStatuses {
+			if !databaseStatus.IsSchemaComplete {
+				problems = append(problems, databaseStatus.DatabaseURL+" does not have a complete Senzing schema")
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return wraperror.Errorf(
+			errForPackage,
+			"prerequisites of phases %v not met: %s",
+			initializer.Phases,
+			strings.Join(problems, "; "),
+		)
+	}
+
+	return nil
+}
+
 // --- Specific database processing -------------------------------------------
 
 func (initializer *BasicInitializer) initializeSpecificDatabaseSqlite(ctx context.Context, parsedURL *url.URL) error {
@@ -2,10 +2,12 @@ package initializer_test
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/senzing-garage/go-helpers/env"
 	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/go-helpers/settingsparser"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/init-database/initializer"
@@ -18,6 +20,7 @@ const (
 )
 
 var (
+	databaseURL       = env.GetEnv("SENZING_TOOLS_DATABASE_URL", "sqlite3://na:na@nowhere/tmp/sqlite/G2C.db")
 	logLevel          = env.GetEnv("SENZING_LOG_LEVEL", "INFO")
 	observerSingleton = &observer.NullObserver{
 		ID:       observerID,
@@ -38,6 +41,175 @@ func TestBasicInitializer_Initialize(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestBasicInitializer_Initialize_configSpecFile(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	testObject.ConfigSpecFile = "../testdata/config-spec/config-spec.yaml"
+	err := testObject.Initialize(ctx)
+	require.NoError(test, err)
+
+	senzingConfig, err := testObject.GetSenzingConfig(ctx)
+	require.NoError(test, err)
+	configDefinition, err := senzingConfig.ExportConfig(ctx, false)
+	require.NoError(test, err)
+	require.Contains(test, configDefinition, `"LOYALTY_ID"`)
+}
+
+func TestBasicInitializer_Initialize_removeDataSources(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	testObject.DataSources = []string{"INITIALIZE_REMOVE_TEST"}
+	err := testObject.Initialize(ctx)
+	require.NoError(test, err)
+
+	testObject = getTestObject(ctx, test)
+	testObject.RemoveDataSources = []string{"INITIALIZE_REMOVE_TEST"}
+	err = testObject.Initialize(ctx)
+	require.NoError(test, err)
+
+	senzingConfig, err := testObject.GetSenzingConfig(ctx)
+	require.NoError(test, err)
+	configDefinition, err := senzingConfig.ExportConfig(ctx, false)
+	require.NoError(test, err)
+	require.NotContains(test, configDefinition, `"INITIALIZE_REMOVE_TEST"`)
+}
+
+func TestBasicInitializer_Initialize_removeDataSourcesWithRecords(test *testing.T) {
+	ctx := test.Context()
+	recordsFile := test.TempDir() + "/records.jsonl"
+	err := os.WriteFile(recordsFile, []byte(`{"DATA_SOURCE": "INITIALIZE_RECORDS_TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}`+"\n"), 0o600)
+	require.NoError(test, err)
+
+	testObject := getTestObject(ctx, test)
+	testObject.DatabaseURLs = []string{databaseURL}
+	testObject.DataSources = []string{"INITIALIZE_RECORDS_TEST"}
+	testObject.LoadURLs = []string{recordsFile}
+	err = testObject.Initialize(ctx)
+	require.NoError(test, err)
+
+	// The template file does not replace the default configuration, so the datasource is checked for records.
+
+	testObject = getTestObject(ctx, test)
+	testObject.RemoveDataSources = []string{"INITIALIZE_RECORDS_TEST"}
+	testObject.SenzingSettingsFile = getTemplateFile(ctx, test)
+	err = testObject.Initialize(ctx)
+	require.Error(test, err)
+
+	senzingConfig, err := testObject.GetSenzingConfig(ctx)
+	require.NoError(test, err)
+	configDefinition, err := senzingConfig.ExportConfig(ctx, false)
+	require.NoError(test, err)
+	require.Contains(test, configDefinition, `"INITIALIZE_RECORDS_TEST"`)
+}
+
+func TestBasicInitializer_Initialize_restart(test *testing.T) {
+	ctx := test.Context()
+	defaultConfigIDs := []int64{}
+
+	// Initializing again with the same files keeps the default configuration.
+
+	for range 2 {
+		testObject := getTestObject(ctx, test)
+		testObject.ConfigSpecFile = "../testdata/config-spec/config-spec.yaml"
+		testObject.SenzingSettingsFile = getTemplateFile(ctx, test)
+		err := testObject.Initialize(ctx)
+		require.NoError(test, err)
+
+		defaultConfigIDs = append(defaultConfigIDs, getDefaultConfigID(ctx, test, testObject))
+	}
+
+	require.Equal(test, defaultConfigIDs[0], defaultConfigIDs[1])
+}
+
+func TestBasicInitializer_Initialize_phases(test *testing.T) {
+	ctx := test.Context()
+
+	for _, phase := range initializer.AllPhases {
+		testObject := getTestObject(ctx, test)
+		testObject.DatabaseURLs = []string{databaseURL}
+		testObject.Phases = []string{phase}
+		err := testObject.Initialize(ctx)
+		require.NoError(test, err, phase)
+	}
+}
+
+func TestBasicInitializer_Initialize_phaseWithoutSchema(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	testObject.DatabaseURLs = []string{"sqlite3://na:na@nowhere" + test.TempDir() + "/G2C.db"}
+	testObject.Phases = []string{initializer.PhaseConfig}
+	err := testObject.Initialize(ctx)
+	require.Error(test, err)
+}
+
+func TestBasicInitializer_Initialize_missingSQLFileWithoutSchemaPhase(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	err := testObject.Initialize(ctx)
+	require.NoError(test, err)
+
+	// The SQL file is only used by the schema phase.
+
+	testObject = getTestObject(ctx, test)
+	testObject.DatabaseURLs = []string{databaseURL}
+	testObject.Phases = []string{initializer.PhaseConfig}
+	testObject.SQLFile = test.TempDir() + "/no-such-file.sql"
+	err = testObject.Initialize(ctx)
+	require.NoError(test, err)
+}
+
+func TestBasicInitializer_Initialize_prescanWithoutConfigPhase(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	err := testObject.Initialize(ctx)
+	require.NoError(test, err)
+
+	// Only the load phase is run, so the prescan registers the datasource itself.
+
+	recordsFile := test.TempDir() + "/records.jsonl"
+	err = os.WriteFile(recordsFile, []byte(`{"DATA_SOURCE": "PRESCAN_TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}`+"\n"), 0o600)
+	require.NoError(test, err)
+
+	testObject = getTestObject(ctx, test)
+	testObject.DatabaseURLs = []string{databaseURL}
+	testObject.LoadURLs = []string{recordsFile}
+	testObject.Phases = []string{initializer.PhaseLoad}
+	testObject.PrescanDataSources = true
+	err = testObject.Initialize(ctx)
+	require.NoError(test, err)
+
+	senzingConfig, err := testObject.GetSenzingConfig(ctx)
+	require.NoError(test, err)
+	configDefinition, err := senzingConfig.ExportConfig(ctx, false)
+	require.NoError(test, err)
+	require.Contains(test, configDefinition, `"PRESCAN_TEST"`)
+}
+
+func TestBasicInitializer_Initialize_missingDataSourcesFile(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	testObject.DataSourcesFile = test.TempDir() + "/no-such-file.txt"
+	err := testObject.Initialize(ctx)
+	require.Error(test, err)
+}
+
+func TestBasicInitializer_Initialize_invalidDataSources(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	testObject.DataSources = []string{"my customers", "WATCHLIST", "1_REFERENCE"}
+	err := testObject.Initialize(ctx)
+	require.ErrorContains(test, err, "MY_CUSTOMERS")
+	require.ErrorContains(test, err, "DS_1_REFERENCE")
+}
+
+func TestBasicInitializer_Initialize_unknownPhase(test *testing.T) {
+	ctx := test.Context()
+	testObject := getTestObject(ctx, test)
+	testObject.Phases = []string{"badPhase"}
+	err := testObject.Initialize(ctx)
+	require.Error(test, err)
+}
+
 func TestBasicInitializer_RegisterObserver(test *testing.T) {
 	ctx := test.Context()
 	observer1 := &observer.NullObserver{
@@ -95,6 +267,40 @@ func TestBasicInitializer_UnregisterObserver(test *testing.T) {
 // Helper functions
 // ----------------------------------------------------------------------------
 
+func getDefaultConfigID(ctx context.Context, t *testing.T, testObject *initializer.BasicInitializer) int64 {
+	t.Helper()
+
+	senzingConfig, err := testObject.GetSenzingConfig(ctx)
+	require.NoError(t, err)
+	configHistory, err := senzingConfig.GetConfigHistory(ctx)
+	require.NoError(t, err)
+
+	for _, entry := range configHistory {
+		if entry.IsDefault {
+			return entry.ConfigID
+		}
+	}
+
+	require.Fail(t, "no default Senzing configuration")
+
+	return 0
+}
+
+func getTemplateFile(ctx context.Context, t *testing.T) string {
+	t.Helper()
+
+	senzingSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(t, err)
+
+	settingsParser, err := settingsparser.New(senzingSettings)
+	require.NoError(t, err)
+
+	resourcePath, err := settingsParser.GetResourcePath(ctx)
+	require.NoError(t, err)
+
+	return resourcePath + "/templates/g2config.json"
+}
+
 func getTestObject(ctx context.Context, t *testing.T) *initializer.BasicInitializer {
 	t.Helper()
 
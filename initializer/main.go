@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/init-database/senzingconfig"
 )
 
 // ----------------------------------------------------------------------------
@@ -12,6 +13,7 @@ import (
 // ----------------------------------------------------------------------------
 
 type Initializer interface {
+	GetSenzingConfig(ctx context.Context) (senzingconfig.SenzingConfig, error)
 	InitializeSenzing(ctx context.Context) error
 	RegisterObserver(ctx context.Context, observer observer.Observer) error
 	SetLogLevel(ctx context.Context, logLevelName string) error
@@ -38,10 +40,24 @@ const (
 
 const observerIDKey = "observerID"
 
+// Phases of initialization, in the order they are run.
+const (
+	PhaseSchema = "schema"
+	PhaseConfig = "config"
+	PhaseLoad   = "load"
+)
+
 // ----------------------------------------------------------------------------
 // Variables
 // ----------------------------------------------------------------------------
 
+// All phases of initialization, in the order they are run.
+var AllPhases = []string{
+	PhaseSchema,
+	PhaseConfig,
+	PhaseLoad,
+}
+
 // Message templates for szconfig implementations.
 var IDMessages = map[int]string{
 	10:   "Enter " + Prefix + "Initialize().",
@@ -56,7 +72,15 @@ var IDMessages = map[int]string{
 	19:   "Exit  " + Prefix + "Initialize(); initializerImpl.registerObserverSenzingSchema; returned (%v).",
 	20:   "Exit  " + Prefix + "Initialize(); initializerImpl.registerObserverSenzingConfig; returned (%v).",
 	21:   "Exit  " + Prefix + "Initialize(); os.Stat failed; returned (%v).",
+	22:   "Exit  " + Prefix + "Initialize(); senzingSchema.CheckPrivileges failed; returned (%v).",
+	23:   "Exit  " + Prefix + "Initialize(); initializerImpl.verifyPhases failed; returned (%v).",
+	24:   "Exit  " + Prefix + "Initialize(); senzingConfig.ApplyConfigSpec failed; returned (%v).",
+	25:   "Exit  " + Prefix + "Initialize(); senzingConfig.UnregisterDataSources failed; returned (%v).",
+	26:   "Exit  " + Prefix + "Initialize(); senzingconfig.LoadDataSourcesFile failed; returned (%v).",
+	27:   "Exit  " + Prefix + "Initialize(); senzingconfig.ValidateDataSourceCodes failed; returned (%v).",
+	28:   "Exit  " + Prefix + "Initialize(); senzingLoad.ScanDataSources failed; returned (%v).",
 	29:   "Exit  " + Prefix + "Initialize() returned (%v).",
+	30:   "Exit  " + Prefix + "Initialize(); initializerImpl.registerScannedDataSources failed; returned (%v).",
 	40:   "Enter " + Prefix + "InitializeSpecificDatabase().",
 	41:   "Exit  " + Prefix + "InitializeSpecificDatabase(); json.Marshal failed; returned (%v).",
 	42:   "Exit  " + Prefix + "InitializeSpecificDatabase(); settingsparser.New failed; returned (%v).",
@@ -105,6 +129,14 @@ var IDMessages = map[int]string{
 	1016: Prefix + "Initialize(); senzingConfig.InitializeSenzing; Error: %v.",
 	1017: Prefix + "Initialize(); initializerImpl.observers.RegisterObserver; returned (%v).",
 	1018: Prefix + "Initialize(); initializerImpl.createGrpcObserver; returned (%v).",
+	1022: Prefix + "Initialize(); senzingSchema.CheckPrivileges failed; Error: %v.",
+	1023: Prefix + "Initialize(); initializerImpl.verifyPhases failed; Error: %v.",
+	1024: Prefix + "Initialize(); senzingConfig.ApplyConfigSpec failed; Error: %v.",
+	1025: Prefix + "Initialize(); senzingConfig.UnregisterDataSources failed; Error: %v.",
+	1026: Prefix + "Initialize(); senzingconfig.LoadDataSourcesFile failed; Error: %v.",
+	1027: Prefix + "Initialize(); senzingconfig.ValidateDataSourceCodes failed; Error: %v.",
+	1028: Prefix + "Initialize(); senzingLoad.ScanDataSources failed; Error: %v.",
+	1030: Prefix + "Initialize(); initializerImpl.registerScannedDataSources failed; Error: %v.",
 	1041: Prefix + "InitializeSpecificDatabase(); json.Marshal failed; Error: %v.",
 	1042: Prefix + "InitializeSpecificDatabase(); settingsparser.New failed; Error: %v.",
 	1043: Prefix + "InitializeSpecificDatabase(); parser.GetDatabaseUrls failed; Error: %v.",
@@ -129,7 +161,10 @@ var IDMessages = map[int]string{
 	1102: Prefix + "initializeSpecificDatabaseSqlite(%v); os.MkdirAll failed; returned (%v).",
 	1103: Prefix + "initializeSpecificDatabaseSqlite(%v); os.Create failed; returned (%v).",
 	2001: "Created file: %s",
+	2002: "Running phases: %v",
+	2003: "Datasources found by pre-scan: %v",
 	3001: "SQL file does not exist: %s",
+	3002: "Load phase requested, but nothing to load. Use --load-truthset, --load-file, or --load-url",
 	8001: Prefix + "Initialize Observer URL",
 	8002: Prefix + "Initialize",
 	8003: Prefix + "RegisterObserver",
@@ -9,4 +9,9 @@ NOT_OK=1
 
 echo "Doing testing."
 
+if ! /app/init-database healthcheck --self-test; then
+    echo "Self test failed."
+    exit ${NOT_OK}
+fi
+
 exit ${OK}
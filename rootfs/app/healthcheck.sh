@@ -1,12 +1,14 @@
 #!/usr/bin/env bash
 
-# Return codes.
+# Return codes follow Docker HEALTHCHECK conventions.
 
 OK=0
 NOT_OK=1
 
 # Tests.
 
-echo "Doing health test."
+if ! /app/init-database healthcheck; then
+    exit ${NOT_OK}
+fi
 
 exit ${OK}
@@ -0,0 +1,85 @@
+package senzingconfig
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The CompareDataSources function lists the differences between expected and registered datasources
+as a ConfigDiff having a single "datasources" section.
+
+Datasources expected but not registered are "Removed".
+Datasources registered but not expected are "Added", unless they are built in.
+
+Input
+  - expectedDataSources: The datasources expected to be registered.
+  - registeredDataSources: The datasources registered in the Senzing configuration.
+  - builtInDataSources: The datasources registered in the Senzing configuration template, which need not be expected.
+
+Output
+  - The differences.
+*/
+func CompareDataSources(
+	expectedDataSources []string,
+	registeredDataSources []string,
+	builtInDataSources []string,
+) ConfigDiff {
+	diffSection := newConfigDiffSection("datasources")
+	expected := MergeDataSources(expectedDataSources)
+	registered := MergeDataSources(registeredDataSources)
+	builtIn := MergeDataSources(builtInDataSources)
+
+	for _, dataSource := range expected {
+		if !slices.Contains(registered, dataSource) {
+			diffSection.Removed = append(diffSection.Removed, dataSource)
+		}
+	}
+
+	for _, dataSource := range registered {
+		if !slices.Contains(expected, dataSource) && !slices.Contains(builtIn, dataSource) {
+			diffSection.Added = append(diffSection.Added, dataSource)
+		}
+	}
+
+	return ConfigDiff{
+		Sections: []ConfigDiffSection{diffSection},
+	}
+}
+
+/*
+The HasConfigDifferences function reports whether any section of a ConfigDiff has differences.
+
+Input
+  - configDiff: The differences.  See CompareConfigs and CompareDataSources.
+
+Output
+  - True if a section has a record added, removed, or updated.
+*/
+func HasConfigDifferences(configDiff ConfigDiff) bool {
+	return slices.ContainsFunc(configDiff.Sections, func(diffSection ConfigDiffSection) bool {
+		return len(diffSection.Added)+len(diffSection.Removed)+len(diffSection.Updated) > 0
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Content that is a JSON object having "G2_CONFIG" is a Senzing configuration, rather than a list of datasources.
+func isConfigDefinition(content []byte) bool {
+	configuration := map[string]json.RawMessage{}
+
+	err := json.Unmarshal(content, &configuration)
+	if err != nil {
+		return false
+	}
+
+	_, isOK := configuration["G2_CONFIG"]
+
+	return isOK
+}
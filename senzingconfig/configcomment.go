@@ -0,0 +1,217 @@
+package senzingconfig
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Separates the comment of a stored Senzing configuration from its labels, which follow as a JSON object.
+const configLabelsSeparator = " labels="
+
+// Key of the metadata added to exported Senzing configurations that have labels.
+const configMetadataKey = "CONFIG_METADATA"
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The FormatConfigComment function fills in the placeholders of a Senzing configuration comment template.
+
+Placeholders are "{operation}", "{time}", "{datasources}", "{host}", "{version}" (the Senzing version of the configuration),
+and "{KEY}" for each label.  Labels replace placeholders having the same name.
+Unknown placeholders are left as they are.
+
+Input
+  - configCommentTemplate: The template.  If empty, DefaultConfigCommentTemplate is used,
+    without "with datasources:" when there are no datasources.
+  - operation: What created the configuration.  Example: "Created".
+  - dataSources: The datasources registered or removed.
+  - version: The Senzing version of the configuration.
+  - labels: Key/value pairs.
+
+Output
+  - The comment.
+*/
+func FormatConfigComment(
+	configCommentTemplate string,
+	operation string,
+	dataSources []string,
+	version string,
+	labels map[string]string,
+) string {
+	if len(configCommentTemplate) == 0 {
+		configCommentTemplate = DefaultConfigCommentTemplate
+		if len(dataSources) == 0 {
+			configCommentTemplate, _, _ = strings.Cut(configCommentTemplate, " with datasources:")
+		}
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	values := map[string]string{
+		"datasources": strings.Join(dataSources, " "),
+		"host":        hostname,
+		"operation":   operation,
+		"time":        time.Now().Format(time.RFC3339),
+		"version":     version,
+	}
+
+	for key, value := range labels {
+		values[key] = value
+	}
+
+	oldNew := []string{}
+	for key, value := range values {
+		oldNew = append(oldNew, "{"+key+"}", value)
+	}
+
+	return strings.NewReplacer(oldNew...).Replace(configCommentTemplate)
+}
+
+/*
+The ParseConfigLabels function parses labels given as "key=value".
+
+Input
+  - configLabels: The labels.  Keys must be non-empty and have no whitespace, "{", or "}".
+
+Output
+  - The labels, by key.  A later value for the same key replaces an earlier one.
+*/
+func ParseConfigLabels(configLabels []string) (map[string]string, error) {
+	result := map[string]string{}
+	problems := []string{}
+
+	for _, configLabel := range configLabels {
+		key, value, isOK := strings.Cut(configLabel, "=")
+		key = strings.TrimSpace(key)
+
+		switch {
+		case !isOK:
+			problems = append(problems, configLabel+": must be key=value")
+		case len(key) == 0 || strings.ContainsFunc(key, isConfigLabelKeyExcluded):
+			problems = append(problems, configLabel+`: key must be non-empty and have no whitespace, "{", or "}"`)
+		default:
+			result[key] = strings.TrimSpace(value)
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, wraperror.Errorf(errForPackage, "invalid config labels: %s", strings.Join(problems, "; "))
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Format the comment of a Senzing configuration using ConfigCommentTemplate and ConfigLabels.
+func (senzingConfig *BasicSenzingConfig) formatConfigComment(
+	operation string,
+	dataSources []string,
+	configDefinition string,
+) string {
+	return FormatConfigComment(
+		senzingConfig.ConfigCommentTemplate,
+		operation,
+		dataSources,
+		getConfigBaseVersion(configDefinition),
+		senzingConfig.ConfigLabels,
+	)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Add labels to the comment of a Senzing configuration, so they are stored with it.
+func addConfigLabels(configComment string, labels map[string]string) string {
+	if len(labels) == 0 {
+		return configComment
+	}
+
+	labelsJSON, err := json.Marshal(labels)
+	if err != nil {
+		return configComment
+	}
+
+	return strings.TrimRight(configComment, " ") + configLabelsSeparator + string(labelsJSON)
+}
+
+// Add the comment and labels of a stored Senzing configuration to its exported JSON.
+func addConfigMetadata(configDefinition string, configID int64, configComment string) (string, error) {
+	comment, labels := splitConfigComment(configComment)
+	if len(labels) == 0 {
+		return configDefinition, nil
+	}
+
+	configuration := map[string]any{}
+
+	err := json.Unmarshal([]byte(configDefinition), &configuration)
+	if err != nil {
+		return configDefinition, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	configuration[configMetadataKey] = map[string]any{
+		"COMMENT":   comment,
+		"CONFIG_ID": configID,
+		"LABELS":    labels,
+	}
+
+	result, err := json.Marshal(configuration)
+	if err != nil {
+		return configDefinition, wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(result), nil
+}
+
+// Return the Senzing version that created a Senzing configuration.
+func getConfigBaseVersion(configDefinition string) string {
+	configuration := struct {
+		G2Config struct {
+			ConfigBaseVersion struct {
+				Version string `json:"VERSION"` //nolint:tagliatelle
+			} `json:"CONFIG_BASE_VERSION"` //nolint:tagliatelle
+		} `json:"G2_CONFIG"` //nolint:tagliatelle
+	}{}
+
+	_ = json.Unmarshal([]byte(configDefinition), &configuration)
+
+	return configuration.G2Config.ConfigBaseVersion.Version
+}
+
+func isConfigLabelKeyExcluded(character rune) bool {
+	return unicode.IsSpace(character) || character == '{' || character == '}'
+}
+
+// Separate the comment of a stored Senzing configuration from its labels.
+func splitConfigComment(configComment string) (string, map[string]string) {
+	index := strings.LastIndex(configComment, configLabelsSeparator)
+	if index < 0 {
+		return configComment, nil
+	}
+
+	labels := map[string]string{}
+
+	err := json.Unmarshal([]byte(configComment[index+len(configLabelsSeparator):]), &labels)
+	if err != nil {
+		return configComment, nil
+	}
+
+	return configComment[:index], labels
+}
@@ -0,0 +1,217 @@
+package senzingconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// configDiffSection maps a section of a ConfigDiff to a G2_CONFIG table.
+type configDiffSection struct {
+	name  string
+	table string
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Sections of a ConfigDiff, in the order reported.
+// Differences in all other parts of G2_CONFIG are reported in the "other" section.
+var configDiffSections = []configDiffSection{
+	{name: "datasources", table: "CFG_DSRC"},
+	{name: "features", table: "CFG_FTYPE"},
+	{name: "attributes", table: "CFG_ATTR"},
+	{name: "thresholds", table: "CFG_CFRTN"},
+	{name: "rules", table: "CFG_ERRULE"},
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The CompareConfigs function lists the differences between two Senzing configurations,
+grouped into datasources, features, attributes, thresholds, rules, and other.
+
+Records are matched by their code or identifier, as in MergeConfigs.
+In the "other" section, keys are prefixed by their G2_CONFIG table.
+Fields describing the Senzing build are ignored.
+
+Input
+  - configDefinition1: The Senzing configuration compared from.
+  - configDefinition2: The Senzing configuration compared to.
+
+Output
+  - The differences.  Every section is present, even if it has no differences.
+*/
+func CompareConfigs(configDefinition1 string, configDefinition2 string) (ConfigDiff, error) {
+	result := ConfigDiff{
+		Sections: []ConfigDiffSection{},
+	}
+	g2Configs := make([]map[string]any, 2)
+
+	for index, configDefinition := range []string{configDefinition1, configDefinition2} {
+		normalizedDefinition, err := removeVolatileFields(configDefinition)
+		if err != nil {
+			return result, wraperror.Errorf(err, "removeVolatileFields")
+		}
+
+		document := map[string]any{}
+
+		err = json.Unmarshal([]byte(normalizedDefinition), &document)
+		if err != nil {
+			return result, wraperror.Errorf(err, "json.Unmarshal")
+		}
+
+		g2Configs[index], _ = document["G2_CONFIG"].(map[string]any)
+	}
+
+	otherSection := newConfigDiffSection("other")
+	sectionTables := []string{}
+
+	for _, section := range configDiffSections {
+		sectionTables = append(sectionTables, section.table)
+		diffSection := newConfigDiffSection(section.name)
+		oldTable, _ := g2Configs[0][section.table].([]any)
+		newTable, _ := g2Configs[1][section.table].([]any)
+		compareTables(section.table, "", oldTable, newTable, &diffSection)
+		result.Sections = append(result.Sections, diffSection)
+	}
+
+	for _, name := range mergeKeys(g2Configs[0], g2Configs[1]) {
+		if slices.Contains(sectionTables, name) {
+			continue
+		}
+
+		oldValue := getMergeValue(g2Configs[0], name)
+		newValue := getMergeValue(g2Configs[1], name)
+		oldTable, isOldTable := oldValue.value.([]any)
+		newTable, isNewTable := newValue.value.([]any)
+
+		switch {
+		case isOldTable && isNewTable:
+			compareTables(name, name+" ", oldTable, newTable, &otherSection)
+		case !reflect.DeepEqual(oldValue, newValue):
+			otherSection.Updated = append(otherSection.Updated, ConfigDiffChange{
+				Key:      name,
+				NewValue: newValue.value,
+				OldValue: oldValue.value,
+			})
+		}
+	}
+
+	result.Sections = append(result.Sections, otherSection)
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Read a Senzing configuration from a file, or from another repository given by Senzing settings JSON or a database URL.
+func (senzingConfig *BasicSenzingConfig) readConfigLocation(ctx context.Context, configLocation string) (string, error) {
+	if isConfigSourceLocation(configLocation) {
+		result, err := senzingConfig.readConfigSource(ctx, configLocation)
+
+		return result, wraperror.Errorf(err, "readConfigSource")
+	}
+
+	content, err := os.ReadFile(filepath.Clean(configLocation))
+	if err != nil {
+		return "", wraperror.Errorf(err, "os.ReadFile: %s", configLocation)
+	}
+
+	return string(content), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Add the differences between the records of two versions of a G2_CONFIG table to a section.
+func compareTables(table string, keyPrefix string, oldTable []any, newTable []any, diffSection *ConfigDiffSection) {
+	oldRecords, oldKeys := indexRecords(table, oldTable)
+	newRecords, newKeys := indexRecords(table, newTable)
+
+	for _, key := range oldKeys {
+		if _, isOK := newRecords[key]; !isOK {
+			diffSection.Removed = append(diffSection.Removed, keyPrefix+key)
+		}
+	}
+
+	for _, key := range newKeys {
+		oldRecord, isOK := oldRecords[key]
+		if !isOK {
+			diffSection.Added = append(diffSection.Added, keyPrefix+key)
+
+			continue
+		}
+
+		oldFields, _ := oldRecord.(map[string]any)
+		newFields, _ := newRecords[key].(map[string]any)
+
+		for _, field := range mergeKeys(oldFields, newFields) {
+			oldValue := getMergeValue(oldFields, field)
+			newValue := getMergeValue(newFields, field)
+
+			if !reflect.DeepEqual(oldValue, newValue) {
+				diffSection.Updated = append(diffSection.Updated, ConfigDiffChange{
+					Field:    field,
+					Key:      keyPrefix + key,
+					NewValue: newValue.value,
+					OldValue: oldValue.value,
+				})
+			}
+		}
+	}
+}
+
+// Describe where a Senzing configuration was read from, without revealing passwords.
+func describeConfigLocation(configLocation string) string {
+	if configID, isOK := getConfigLocationID(configLocation); isOK {
+		return fmt.Sprintf("Senzing configuration %d", configID)
+	}
+
+	if isConfigSourceLocation(configLocation) {
+		return describeConfigSource(configLocation)
+	}
+
+	return configLocation
+}
+
+// A configuration location that is a positive integer is the ID of a stored Senzing configuration.
+func getConfigLocationID(configLocation string) (int64, bool) {
+	configID, err := strconv.ParseInt(strings.TrimSpace(configLocation), 10, 64)
+
+	return configID, err == nil && configID > 0
+}
+
+// A configuration location that is Senzing settings JSON or a database URL is another repository.
+func isConfigSourceLocation(configLocation string) bool {
+	configLocation = strings.TrimSpace(configLocation)
+
+	return strings.HasPrefix(configLocation, "{") || strings.Contains(configLocation, "://")
+}
+
+func newConfigDiffSection(name string) ConfigDiffSection {
+	return ConfigDiffSection{
+		Added:   []string{},
+		Name:    name,
+		Removed: []string{},
+		Updated: []ConfigDiffChange{},
+	}
+}
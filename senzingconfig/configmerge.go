@@ -0,0 +1,380 @@
+package senzingconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A value that may be missing from a configuration.
+type configMergeValue struct {
+	isPresent bool
+	value     any
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Fields identifying a record of a G2_CONFIG table.
+// Records of other tables are identified by their whole content.
+var configMergeKeyFields = map[string][]string{
+	"CFG_ATTR":   {"ATTR_CODE"},
+	"CFG_CFCALL": {"CFCALL_ID"},
+	"CFG_CFRTN":  {"CFRTN_ID"},
+	"CFG_CFUNC":  {"CFUNC_CODE"},
+	"CFG_DFCALL": {"DFCALL_ID"},
+	"CFG_DFUNC":  {"DFUNC_CODE"},
+	"CFG_DSRC":   {"DSRC_CODE"},
+	"CFG_EFCALL": {"EFCALL_ID"},
+	"CFG_EFUNC":  {"EFUNC_CODE"},
+	"CFG_ERFRAG": {"ERFRAG_CODE"},
+	"CFG_ERRULE": {"ERRULE_CODE"},
+	"CFG_FBOM":   {"FTYPE_ID", "FELEM_ID"},
+	"CFG_FELEM":  {"FELEM_CODE"},
+	"CFG_FTYPE":  {"FTYPE_CODE"},
+	"CFG_GPLAN":  {"GPLAN_CODE"},
+	"CFG_SFCALL": {"SFCALL_ID"},
+	"CFG_SFUNC":  {"SFUNC_CODE"},
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The MergeConfigs function does a three-way merge of Senzing configurations.
+
+Changes made in the current configuration since the base configuration are kept,
+and changes made in the new template since the base configuration are added.
+Where both changed the same value differently, the current value is kept and a conflict is reported.
+Records of G2_CONFIG tables are matched by their code or identifier, and merged field by field.
+
+Input
+  - baseDefinition: The Senzing configuration template the current configuration was based on.
+  - currentDefinition: The current Senzing configuration.
+  - newDefinition: The new Senzing configuration template.
+
+Output
+  - The merged Senzing configuration.
+  - A description of each change to the current configuration.
+  - The conflicts.
+*/
+func MergeConfigs(
+	baseDefinition string,
+	currentDefinition string,
+	newDefinition string,
+) (string, []string, []ConfigMergeConflict, error) {
+	changes := []string{}
+	conflicts := []ConfigMergeConflict{}
+	documents := make([]map[string]any, 3)
+
+	for index, configDefinition := range []string{baseDefinition, currentDefinition, newDefinition} {
+		err := json.Unmarshal([]byte(configDefinition), &documents[index])
+		if err != nil {
+			return "", changes, conflicts, wraperror.Errorf(err, "json.Unmarshal")
+		}
+	}
+
+	base, current, newer := documents[0], documents[1], documents[2]
+	result := map[string]any{}
+
+	for _, section := range mergeKeys(current, newer) {
+		currentG2Config, isCurrentOK := current[section].(map[string]any)
+		newG2Config, isNewOK := newer[section].(map[string]any)
+
+		if section == "G2_CONFIG" && isCurrentOK && isNewOK {
+			baseG2Config, _ := base[section].(map[string]any)
+			result[section] = mergeG2Config(baseG2Config, currentG2Config, newG2Config, &changes, &conflicts)
+
+			continue
+		}
+
+		merged := mergeValues(getMergeValue(base, section), getMergeValue(current, section), getMergeValue(newer, section),
+			func(currentValue configMergeValue, newValue configMergeValue) {
+				conflicts = append(conflicts, newConfigMergeConflict(section, "", "", currentValue, newValue))
+			},
+		)
+
+		if merged.isPresent {
+			result[section] = merged.value
+		}
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", changes, conflicts, wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(resultJSON), changes, conflicts, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Merge the tables and other sections of G2_CONFIG.
+func mergeG2Config(
+	base map[string]any,
+	current map[string]any,
+	newer map[string]any,
+	changes *[]string,
+	conflicts *[]ConfigMergeConflict,
+) map[string]any {
+	result := map[string]any{}
+
+	for _, section := range mergeKeys(current, newer) {
+		baseTable, _ := base[section].([]any)
+		currentTable, isCurrentOK := current[section].([]any)
+		newTable, isNewOK := newer[section].([]any)
+
+		if isCurrentOK && isNewOK {
+			result[section] = mergeTable(section, baseTable, currentTable, newTable, changes, conflicts)
+
+			continue
+		}
+
+		currentValue := getMergeValue(current, section)
+		merged := mergeValues(getMergeValue(base, section), currentValue, getMergeValue(newer, section),
+			func(currentValue configMergeValue, newValue configMergeValue) {
+				*conflicts = append(*conflicts, newConfigMergeConflict(section, "", "", currentValue, newValue))
+			},
+		)
+
+		if !reflect.DeepEqual(merged, currentValue) {
+			*changes = append(*changes, "updated "+section)
+		}
+
+		if merged.isPresent {
+			result[section] = merged.value
+		}
+	}
+
+	return result
+}
+
+// Merge the records of a G2_CONFIG table.
+func mergeTable(
+	section string,
+	base []any,
+	current []any,
+	newer []any,
+	changes *[]string,
+	conflicts *[]ConfigMergeConflict,
+) []any {
+	result := []any{}
+	baseRecords, _ := indexRecords(section, base)
+	currentRecords, currentKeys := indexRecords(section, current)
+	newRecords, newKeys := indexRecords(section, newer)
+
+	// Records in the new template come first, followed by records only in the current configuration.
+
+	keys := newKeys
+	for _, key := range currentKeys {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		baseRecord := getMergeValue(baseRecords, key)
+		currentRecord := getMergeValue(currentRecords, key)
+		newRecord := getMergeValue(newRecords, key)
+
+		var merged configMergeValue
+
+		currentFields, isCurrentOK := currentRecord.value.(map[string]any)
+		newFields, isNewOK := newRecord.value.(map[string]any)
+
+		if isCurrentOK && isNewOK && isMergeConflict(baseRecord, currentRecord, newRecord) {
+			// Records changed in both are merged field by field.
+
+			baseFields, _ := baseRecord.value.(map[string]any)
+			merged = configMergeValue{
+				isPresent: true,
+				value:     mergeRecord(section, key, baseFields, currentFields, newFields, conflicts),
+			}
+		} else {
+			merged = mergeValues(baseRecord, currentRecord, newRecord,
+				func(currentValue configMergeValue, newValue configMergeValue) {
+					*conflicts = append(*conflicts, newConfigMergeConflict(section, key, "", currentValue, newValue))
+				},
+			)
+		}
+
+		*changes = append(*changes, describeRecordChange(section, key, currentRecord, merged)...)
+
+		if merged.isPresent {
+			result = append(result, merged.value)
+		}
+	}
+
+	return result
+}
+
+// Merge the fields of a record changed in both the current configuration and the new template.
+func mergeRecord(
+	section string,
+	key string,
+	base map[string]any,
+	current map[string]any,
+	newer map[string]any,
+	conflicts *[]ConfigMergeConflict,
+) map[string]any {
+	result := map[string]any{}
+
+	for _, field := range mergeKeys(current, newer) {
+		merged := mergeValues(getMergeValue(base, field), getMergeValue(current, field), getMergeValue(newer, field),
+			func(currentValue configMergeValue, newValue configMergeValue) {
+				*conflicts = append(*conflicts, newConfigMergeConflict(section, key, field, currentValue, newValue))
+			},
+		)
+
+		if merged.isPresent {
+			result[field] = merged.value
+		}
+	}
+
+	return result
+}
+
+// Three-way merge of a single value.  On conflict, the current value is kept.
+func mergeValues(
+	base configMergeValue,
+	current configMergeValue,
+	newer configMergeValue,
+	onConflict func(currentValue configMergeValue, newValue configMergeValue),
+) configMergeValue {
+	if isMergeConflict(base, current, newer) {
+		onConflict(current, newer)
+
+		return current
+	}
+
+	if reflect.DeepEqual(current, base) {
+		return newer
+	}
+
+	return current
+}
+
+// A conflict is when the current value and the new value differ from each other and from the base value.
+func isMergeConflict(base configMergeValue, current configMergeValue, newer configMergeValue) bool {
+	return !reflect.DeepEqual(current, newer) && !reflect.DeepEqual(current, base) && !reflect.DeepEqual(newer, base)
+}
+
+// Describe how a merged record differs from the current record.
+func describeRecordChange(section string, key string, current configMergeValue, merged configMergeValue) []string {
+	switch {
+	case reflect.DeepEqual(current, merged):
+		return []string{}
+	case !current.isPresent:
+		return []string{fmt.Sprintf("added %s %s", section, key)}
+	case !merged.isPresent:
+		return []string{fmt.Sprintf("removed %s %s", section, key)}
+	}
+
+	currentFields, _ := current.value.(map[string]any)
+	mergedFields, _ := merged.value.(map[string]any)
+	changedFields := []string{}
+
+	for _, field := range mergeKeys(currentFields, mergedFields) {
+		if !reflect.DeepEqual(getMergeValue(currentFields, field), getMergeValue(mergedFields, field)) {
+			changedFields = append(changedFields, field)
+		}
+	}
+
+	return []string{fmt.Sprintf("updated %s %s (%s)", section, key, strings.Join(changedFields, ", "))}
+}
+
+// Map each record of a table to the key that identifies it.  Also return the keys in table order.
+func indexRecords(section string, table []any) (map[string]any, []string) {
+	records := map[string]any{}
+	keys := []string{}
+
+	for _, row := range table {
+		key := getRecordKey(section, row)
+		if _, isOK := records[key]; isOK {
+			continue
+		}
+
+		records[key] = row
+		keys = append(keys, key)
+	}
+
+	return records, keys
+}
+
+// Identify a record by its key fields, or by its whole content.
+func getRecordKey(section string, row any) string {
+	record, isOK := row.(map[string]any)
+	keyFields, hasKeyFields := configMergeKeyFields[section]
+
+	if isOK && hasKeyFields {
+		keyValues := []string{}
+
+		for _, keyField := range keyFields {
+			value, isOK := record[keyField]
+			if !isOK {
+				break
+			}
+
+			keyValues = append(keyValues, fmt.Sprintf("%s=%v", keyField, value))
+		}
+
+		if len(keyValues) == len(keyFields) {
+			return strings.Join(keyValues, ",")
+		}
+	}
+
+	content, _ := json.Marshal(row)
+
+	return string(content)
+}
+
+func getMergeValue[T any](aMap map[string]T, key string) configMergeValue {
+	value, isOK := aMap[key]
+
+	return configMergeValue{isPresent: isOK, value: value}
+}
+
+// Return the keys of two maps, sorted.
+func mergeKeys[T any](map1 map[string]T, map2 map[string]T) []string {
+	result := []string{}
+
+	for _, aMap := range []map[string]T{map1, map2} {
+		for key := range aMap {
+			if !slices.Contains(result, key) {
+				result = append(result, key)
+			}
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+func newConfigMergeConflict(
+	section string,
+	key string,
+	field string,
+	currentValue configMergeValue,
+	newValue configMergeValue,
+) ConfigMergeConflict {
+	return ConfigMergeConflict{
+		CurrentValue:  currentValue.value,
+		Field:         field,
+		Key:           key,
+		Section:       section,
+		TemplateValue: newValue.value,
+	}
+}
@@ -0,0 +1,126 @@
+package senzingconfig
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The PlanConfigPrune function decides which stored Senzing configurations a prune keeps and removes.
+
+The default configuration, the keep most recent configurations, and pinned configurations are kept.
+A configuration is pinned if its ID is in pinnedConfigIDs or its PinnedConfigLabel label is "true".
+All other configurations are removed.
+
+Input
+  - configHistory: The stored configurations.  See GetConfigHistory.
+  - keep: The number of most recent configurations to keep.
+  - pinnedConfigIDs: The IDs of configurations to keep.
+
+Output
+  - The configurations kept and removed, newest first.
+*/
+func PlanConfigPrune(configHistory []ConfigHistoryEntry, keep int, pinnedConfigIDs []int64) ConfigPrunePlan {
+	result := ConfigPrunePlan{
+		Kept:    []ConfigPruneEntry{},
+		Removed: []ConfigPruneEntry{},
+	}
+
+	newestFirst := slices.Clone(configHistory)
+	slices.SortStableFunc(newestFirst, func(a, b ConfigHistoryEntry) int {
+		return cmp.Or(strings.Compare(b.CreatedOn, a.CreatedOn), cmp.Compare(b.ConfigID, a.ConfigID))
+	})
+
+	for index, configHistoryEntry := range newestFirst {
+		configPruneEntry := ConfigPruneEntry{
+			Comment:   configHistoryEntry.Comment,
+			ConfigID:  configHistoryEntry.ConfigID,
+			CreatedOn: configHistoryEntry.CreatedOn,
+		}
+
+		switch {
+		case configHistoryEntry.IsDefault:
+			configPruneEntry.Reason = ConfigPruneReasonDefault
+		case slices.Contains(pinnedConfigIDs, configHistoryEntry.ConfigID),
+			strings.EqualFold(configHistoryEntry.Labels[PinnedConfigLabel], "true"):
+			configPruneEntry.Reason = ConfigPruneReasonPinned
+		case index < keep:
+			configPruneEntry.Reason = ConfigPruneReasonRecent
+		default:
+			result.Removed = append(result.Removed, configPruneEntry)
+
+			continue
+		}
+
+		result.Kept = append(result.Kept, configPruneEntry)
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Delete stored Senzing configurations from the primary database in a single transaction.
+The Senzing SDK has no method for deleting a stored configuration.
+
+The default configuration is never deleted, even if another process made it the default after the prune was planned:
+each DELETE skips the configuration that SYS_VARS names as the default, and if one is skipped,
+the transaction is rolled back.
+*/
+func (senzingConfig *BasicSenzingConfig) deleteConfigs(ctx context.Context, configIDs []int64) error {
+	database, err := senzingConfig.openPrimaryDatabase(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "openPrimaryDatabase")
+	}
+
+	defer func() { _ = database.Close() }()
+
+	transaction, err := database.BeginTx(ctx, nil)
+	if err != nil {
+		return wraperror.Errorf(err, "BeginTx")
+	}
+
+	defer func() { _ = transaction.Rollback() }()
+
+	for _, configID := range configIDs {
+		// CONFIG_DATA_ID is an integer, so formatting it into the statement avoids database-specific placeholders.
+		// SYS_VARS holds the default configuration ID as text.
+
+		formattedConfigID := strconv.FormatInt(configID, 10)
+		sqlStatement := "DELETE FROM SYS_CFG WHERE CONFIG_DATA_ID = " + formattedConfigID +
+			" AND NOT EXISTS (SELECT 1 FROM SYS_VARS WHERE VAR_GROUP = 'CONFIG' AND VAR_CODE = 'DEFAULT_CONFIG_ID'" +
+			" AND VAR_VALUE = '" + formattedConfigID + "')"
+
+		sqlResult, err := transaction.ExecContext(ctx, sqlStatement)
+		if err != nil {
+			return wraperror.Errorf(err, "ExecContext: %s", sqlStatement)
+		}
+
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			return wraperror.Errorf(err, "RowsAffected")
+		}
+
+		if rowsAffected == 0 {
+			return wraperror.Errorf(
+				errForPackage,
+				"Senzing configuration %d is the default or was already removed; no configurations were removed",
+				configID,
+			)
+		}
+	}
+
+	return wraperror.Errorf(transaction.Commit(), "Commit")
+}
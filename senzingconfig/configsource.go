@@ -0,0 +1,362 @@
+package senzingconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	helpersettings "github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-sdk-abstract-factory/szfactorycreator"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The BuildConfigSourceSettings function returns the Senzing settings used to read the configuration source.
+
+A configuration source is either Senzing settings JSON, which is returned unchanged,
+or a database URL, which replaces the database connection of the target's Senzing settings.
+
+Input
+  - senzingSettings: The Senzing settings of the target repository.
+  - configSource: Senzing settings JSON or a database URL of the source repository.
+
+Output
+  - Senzing settings for the source repository.
+*/
+func BuildConfigSourceSettings(senzingSettings string, configSource string) (string, error) {
+	configSource = strings.TrimSpace(configSource)
+	if strings.HasPrefix(configSource, "{") {
+		return configSource, nil
+	}
+
+	databaseURI, err := helpersettings.BuildSenzingDatabaseURI(configSource)
+	if err != nil {
+		return "", wraperror.Errorf(err, "BuildSenzingDatabaseURI: %s", describeConfigSource(configSource))
+	}
+
+	settings := map[string]any{}
+
+	err = json.Unmarshal([]byte(senzingSettings), &settings)
+	if err != nil {
+		return "", wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	// A single connection replaces any multi-database (HYBRID) setup of the target.
+
+	settings["SQL"] = map[string]any{
+		"CONNECTION": databaseURI,
+	}
+
+	result, err := json.Marshal(settings)
+	if err != nil {
+		return "", wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(result), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Read the default Senzing configuration of the repository given by Senzing settings JSON or a database URL.
+
+Senzing supports one set of settings per process at a time, so this must be done
+before any Senzing objects are created for the target repository.
+*/
+func (senzingConfig *BasicSenzingConfig) readConfigSource(ctx context.Context, configSource string) (string, error) {
+	if len(senzingConfig.GrpcTarget) > 0 {
+		return "", wraperror.Errorf(errForPackage, "a config source cannot be used with gRPC")
+	}
+
+	sourceSettings, err := BuildConfigSourceSettings(senzingConfig.SenzingSettings, configSource)
+	if err != nil {
+		return "", wraperror.Errorf(err, "BuildConfigSourceSettings")
+	}
+
+	szAbstractFactory, err := szfactorycreator.CreateCoreAbstractFactory(
+		fmt.Sprintf("senzing init-database config source at %s", time.Now()),
+		sourceSettings,
+		senzingConfig.SenzingVerboseLogging,
+		senzing.SzInitializeWithDefaultConfiguration,
+	)
+	if err != nil {
+		return "", wraperror.Errorf(err, "CreateCoreAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return "", wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return "", wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if configID == 0 {
+		return "", wraperror.Errorf(
+			errForPackage,
+			"%s has no default Senzing configuration",
+			describeConfigSource(configSource),
+		)
+	}
+
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		return "", wraperror.Errorf(err, "CreateConfigFromConfigID: %d", configID)
+	}
+
+	result, err := szConfig.Export(ctx)
+
+	return result, wraperror.Errorf(err, "Export")
+}
+
+/*
+Install the Senzing configuration read from ConfigSource as the default Senzing configuration.
+
+With ConfigSourceModeClone, the source configuration replaces the target's default configuration.
+With ConfigSourceModeMerge, only the source's datasources are added to the target's default configuration.
+In both cases, DataSources are also registered.
+
+Returns the default configuration ID.
+*/
+func (senzingConfig *BasicSenzingConfig) installConfigSource(
+	ctx context.Context,
+	szAbstractFactory senzing.SzAbstractFactory,
+	sourceDefinition string,
+) (int64, error) {
+	configID, isChanged, err := senzingConfig.mergeConfigSource(ctx, szAbstractFactory, sourceDefinition)
+	if err != nil {
+		return configID, err
+	}
+
+	configSource := describeConfigSource(senzingConfig.ConfigSource)
+
+	configSourceMode := senzingConfig.ConfigSourceMode
+	if len(configSourceMode) == 0 {
+		configSourceMode = ConfigSourceModeClone
+	}
+
+	if isChanged {
+		senzingConfig.log(2015, configSource, configSourceMode, configID)
+	} else {
+		senzingConfig.log(2016, configID, configSource, configSourceMode)
+	}
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"configID":         strconv.FormatInt(configID, 10),
+				"configSource":     configSource,
+				"configSourceMode": configSourceMode,
+				"isChanged":        strconv.FormatBool(isChanged),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8014, err, details)
+		}()
+	}
+
+	return configID, nil
+}
+
+/*
+Make the default Senzing configuration reflect the configuration read from ConfigSource.
+
+Returns the default configuration ID and whether a new configuration was made the default.
+*/
+func (senzingConfig *BasicSenzingConfig) mergeConfigSource(
+	ctx context.Context,
+	szAbstractFactory senzing.SzAbstractFactory,
+	sourceDefinition string,
+) (int64, bool, error) {
+	var result int64
+
+	isMerge, err := senzingConfig.isConfigSourceMerge()
+	if err != nil {
+		return result, false, err
+	}
+
+	compatibilityVersion, err := getEngineCompatibilityVersion(ctx, szAbstractFactory)
+	if err != nil {
+		return result, false, wraperror.Errorf(err, "getEngineCompatibilityVersion")
+	}
+
+	err = ValidateConfigDefinition(sourceDefinition, compatibilityVersion)
+	if err != nil {
+		return result, false, wraperror.Errorf(err, "ValidateConfigDefinition")
+	}
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return result, false, wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	sourceSzConfig, err := szConfigManager.CreateConfigFromString(ctx, sourceDefinition)
+	if err != nil {
+		return result, false, wraperror.Errorf(err, "CreateConfigFromString")
+	}
+
+	dataSources := slices.Clone(senzingConfig.DataSources)
+
+	if isMerge {
+		sourceDataSources, err := getRegisteredDataSources(ctx, sourceSzConfig)
+		if err != nil {
+			return result, false, wraperror.Errorf(err, "getRegisteredDataSources")
+		}
+
+		dataSources = append(sourceDataSources, dataSources...)
+	}
+
+	// If there is no default configuration, the source (clone) or the template (merge) becomes the default.
+
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return result, false, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if configID == 0 {
+		szConfig := sourceSzConfig
+		if isMerge {
+			szConfig, err = szConfigManager.CreateConfigFromTemplate(ctx)
+			if err != nil {
+				return result, false, wraperror.Errorf(err, "CreateConfigFromTemplate")
+			}
+		}
+
+		result, isChanged, err := senzingConfig.makeDefaultConfig(ctx, szAbstractFactory, szConfig, dataSources)
+
+		return result, isChanged, wraperror.Errorf(err, "makeDefaultConfig")
+	}
+
+	// Otherwise, update the default configuration.
+
+	result, isChanged, err := senzingConfig.updateDefaultConfig(
+		ctx,
+		szAbstractFactory,
+		func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error) {
+			if isMerge {
+				missingDataSources, err := getMissingDataSources(ctx, szConfig, dataSources)
+				if err != nil || len(missingDataSources) == 0 {
+					return "", "", wraperror.Errorf(err, "getMissingDataSources")
+				}
+
+				return senzingConfig.registerDataSources(ctx, szConfig, missingDataSources)
+			}
+
+			return senzingConfig.cloneConfigSource(ctx, szConfigManager, szConfig, sourceDefinition)
+		},
+	)
+
+	return result, isChanged, wraperror.Errorf(err, "updateDefaultConfig")
+}
+
+// Return the source configuration, with DataSources registered, unless it matches the current configuration.
+func (senzingConfig *BasicSenzingConfig) cloneConfigSource(
+	ctx context.Context,
+	szConfigManager senzing.SzConfigManager,
+	currentSzConfig senzing.SzConfig,
+	sourceDefinition string,
+) (string, string, error) {
+	szConfig, err := szConfigManager.CreateConfigFromString(ctx, sourceDefinition)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "CreateConfigFromString")
+	}
+
+	missingDataSources, err := getMissingDataSources(ctx, szConfig, senzingConfig.DataSources)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "getMissingDataSources")
+	}
+
+	configDefinition, _, err := senzingConfig.registerDataSources(ctx, szConfig, missingDataSources)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "registerDataSources")
+	}
+
+	currentDefinition, err := currentSzConfig.Export(ctx)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "Export")
+	}
+
+	isSame, err := isSameConfigDefinition(configDefinition, currentDefinition)
+	if err != nil || isSame {
+		return "", "", wraperror.Errorf(err, "isSameConfigDefinition")
+	}
+
+	configComment := senzingConfig.formatConfigComment(
+		"Cloned from "+describeConfigSource(senzingConfig.ConfigSource),
+		nil,
+		configDefinition,
+	)
+
+	return configDefinition, configComment, nil
+}
+
+// Interpret ConfigSourceMode.  The default is ConfigSourceModeClone.
+func (senzingConfig *BasicSenzingConfig) isConfigSourceMerge() (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(senzingConfig.ConfigSourceMode)) {
+	case "", ConfigSourceModeClone:
+		return false, nil
+	case ConfigSourceModeMerge:
+		return true, nil
+	default:
+		return false, wraperror.Errorf(
+			errForPackage,
+			"unknown config source mode %q; must be %q or %q",
+			senzingConfig.ConfigSourceMode,
+			ConfigSourceModeClone,
+			ConfigSourceModeMerge,
+		)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Describe a configuration source without revealing passwords.
+func describeConfigSource(configSource string) string {
+	configSource = strings.TrimSpace(configSource)
+	if strings.HasPrefix(configSource, "{") {
+		return "Senzing settings JSON"
+	}
+
+	parsedURL, err := url.Parse(configSource)
+	if err != nil {
+		return "unparsable database URL"
+	}
+
+	return parsedURL.Redacted()
+}
+
+// Compare two Senzing configurations, ignoring fields that describe the Senzing build.
+func isSameConfigDefinition(configDefinition1 string, configDefinition2 string) (bool, error) {
+	normalized1, err := removeVolatileFields(configDefinition1)
+	if err != nil {
+		return false, wraperror.Errorf(err, "removeVolatileFields")
+	}
+
+	normalized2, err := removeVolatileFields(configDefinition2)
+	if err != nil {
+		return false, wraperror.Errorf(err, "removeVolatileFields")
+	}
+
+	return normalized1 == normalized2, nil
+}
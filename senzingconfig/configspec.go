@@ -0,0 +1,347 @@
+package senzingconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"go.yaml.in/yaml/v3"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+ConfigSpec is a declarative description of the desired Senzing configuration.
+It is read from a YAML or JSON document.
+
+Each entry of Attributes, ComparisonThresholds, Features, and Rules is a record
+of the corresponding G2_CONFIG table, keyed by column name.
+If a record having the same key already exists, only the given columns are changed.
+Otherwise the record is added and, if not given, its identifier is assigned.
+*/
+type ConfigSpec struct {
+	Attributes           []map[string]any `json:"attributes,omitempty"`
+	ComparisonThresholds []map[string]any `json:"comparisonThresholds,omitempty"`
+	DataSources          []string         `json:"dataSources,omitempty"`
+	Features             []map[string]any `json:"features,omitempty"`
+	Rules                []map[string]any `json:"rules,omitempty"`
+}
+
+// configSpecSection maps a section of a ConfigSpec to a G2_CONFIG table.
+type configSpecSection struct {
+	idField  string
+	keyField string
+	records  func(configSpec *ConfigSpec) []map[string]any
+	table    string
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Sections are applied in this order, so that features exist before attributes refer to them.
+var configSpecSections = []configSpecSection{
+	{
+		idField:  "FTYPE_ID",
+		keyField: "FTYPE_CODE",
+		records:  func(configSpec *ConfigSpec) []map[string]any { return configSpec.Features },
+		table:    "CFG_FTYPE",
+	},
+	{
+		idField:  "ATTR_ID",
+		keyField: "ATTR_CODE",
+		records:  func(configSpec *ConfigSpec) []map[string]any { return configSpec.Attributes },
+		table:    "CFG_ATTR",
+	},
+	{
+		idField:  "CFRTN_ID",
+		keyField: "CFRTN_ID",
+		records:  func(configSpec *ConfigSpec) []map[string]any { return configSpec.ComparisonThresholds },
+		table:    "CFG_CFRTN",
+	},
+	{
+		idField:  "ERRULE_ID",
+		keyField: "ERRULE_CODE",
+		records:  func(configSpec *ConfigSpec) []map[string]any { return configSpec.Rules },
+		table:    "CFG_ERRULE",
+	},
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The LoadConfigSpec function reads a ConfigSpec from a YAML or JSON file.
+
+Input
+  - configSpecFile: Path to the file.
+
+Output
+  - The parsed ConfigSpec.
+*/
+func LoadConfigSpec(configSpecFile string) (*ConfigSpec, error) {
+	content, err := os.ReadFile(filepath.Clean(configSpecFile))
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile: %s", configSpecFile)
+	}
+
+	return ParseConfigSpec(content)
+}
+
+/*
+The ParseConfigSpec function parses a ConfigSpec from YAML or JSON.
+Unknown sections are reported as errors.
+
+Input
+  - content: The YAML or JSON document.
+
+Output
+  - The parsed ConfigSpec.
+*/
+func ParseConfigSpec(content []byte) (*ConfigSpec, error) {
+	result := &ConfigSpec{}
+
+	// YAML is a superset of JSON, so one parser handles both.
+	// Converting to JSON gives numbers the same representation as in a Senzing configuration.
+
+	document := map[string]any{}
+
+	err := yaml.Unmarshal(content, &document)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "yaml.Unmarshal")
+	}
+
+	documentJSON, err := json.Marshal(document)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Marshal")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(documentJSON))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "invalid config spec")
+	}
+
+	for _, section := range configSpecSections {
+		for index, record := range section.records(result) {
+			if _, isOK := record[section.keyField]; !isOK {
+				return nil, wraperror.Errorf(
+					errForPackage,
+					"invalid config spec: record %d for %s is missing %s",
+					index,
+					section.table,
+					section.keyField,
+				)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Apply a ConfigSpec to a Senzing configuration.
+
+Returns the new configuration and a description of each change.
+If nothing changed, the configuration returned is empty.
+*/
+func applyConfigSpecToSzConfig(
+	ctx context.Context,
+	szConfig senzing.SzConfig,
+	configSpec *ConfigSpec,
+) (string, []string, error) {
+	changes := []string{}
+
+	// Datasources.
+
+	missingDataSources, err := getMissingDataSources(ctx, szConfig, configSpec.DataSources)
+	if err != nil {
+		return "", changes, wraperror.Errorf(err, "getMissingDataSources")
+	}
+
+	for _, dataSource := range missingDataSources {
+		_, err = szConfig.RegisterDataSource(ctx, dataSource)
+		if err != nil {
+			return "", changes, wraperror.Errorf(err, "RegisterDataSource: %s", dataSource)
+		}
+
+		changes = append(changes, "added CFG_DSRC DSRC_CODE="+dataSource)
+	}
+
+	configDefinition, err := szConfig.Export(ctx)
+	if err != nil {
+		return "", changes, wraperror.Errorf(err, "Export")
+	}
+
+	// Attributes, comparison thresholds, features, and rules.
+
+	configDefinition, tableChanges, err := applyConfigSpec(configDefinition, configSpec)
+	if err != nil {
+		return "", changes, wraperror.Errorf(err, "applyConfigSpec")
+	}
+
+	changes = append(changes, tableChanges...)
+
+	if len(changes) == 0 {
+		return "", changes, nil
+	}
+
+	return configDefinition, changes, nil
+}
+
+/*
+Apply the Attributes, ComparisonThresholds, Features, and Rules of a ConfigSpec
+to a Senzing configuration.  DataSources are registered separately using SzConfig.
+
+Returns the new configuration and a description of each change.
+*/
+func applyConfigSpec(configDefinition string, configSpec *ConfigSpec) (string, []string, error) {
+	var changes []string
+
+	configuration := map[string]any{}
+
+	err := json.Unmarshal([]byte(configDefinition), &configuration)
+	if err != nil {
+		return configDefinition, changes, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	g2Config, isOK := configuration["G2_CONFIG"].(map[string]any)
+	if !isOK {
+		return configDefinition, changes, wraperror.Errorf(errForPackage, "configuration is missing G2_CONFIG")
+	}
+
+	for _, section := range configSpecSections {
+		sectionChanges, err := applyConfigSpecSection(g2Config, section, section.records(configSpec))
+		if err != nil {
+			return configDefinition, changes, wraperror.Errorf(err, "applyConfigSpecSection: %s", section.table)
+		}
+
+		changes = append(changes, sectionChanges...)
+	}
+
+	if len(changes) == 0 {
+		return configDefinition, changes, nil
+	}
+
+	result, err := json.Marshal(configuration)
+	if err != nil {
+		return configDefinition, changes, wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(result), changes, nil
+}
+
+// Upsert records into a single G2_CONFIG table.
+func applyConfigSpecSection(
+	g2Config map[string]any,
+	section configSpecSection,
+	specRecords []map[string]any,
+) ([]string, error) {
+	var changes []string
+
+	if len(specRecords) == 0 {
+		return changes, nil
+	}
+
+	rawTable, isOK := g2Config[section.table]
+	if !isOK {
+		rawTable = []any{}
+	}
+
+	table, isOK := rawTable.([]any)
+	if !isOK {
+		return changes, wraperror.Errorf(errForPackage, "%s is not a list", section.table)
+	}
+
+	for _, specRecord := range specRecords {
+		key := specRecord[section.keyField]
+		index := slices.IndexFunc(table, func(row any) bool {
+			record, isOK := row.(map[string]any)
+
+			return isOK && reflect.DeepEqual(record[section.keyField], key)
+		})
+
+		// Add new record.
+
+		if index < 0 {
+			record := map[string]any{}
+			for field, value := range specRecord {
+				record[field] = value
+			}
+
+			if _, hasID := record[section.idField]; !hasID {
+				record[section.idField] = nextID(table, section.idField)
+			}
+
+			table = append(table, record)
+			changes = append(changes, fmt.Sprintf("added %s %s=%v", section.table, section.keyField, key))
+
+			continue
+		}
+
+		// Update existing record.
+
+		record, _ := table[index].(map[string]any)
+		changedFields := []string{}
+
+		for field, value := range specRecord {
+			if !reflect.DeepEqual(record[field], value) {
+				record[field] = value
+				changedFields = append(changedFields, field)
+			}
+		}
+
+		if len(changedFields) > 0 {
+			slices.Sort(changedFields)
+			changes = append(
+				changes,
+				fmt.Sprintf(
+					"updated %s %s=%v (%s)",
+					section.table,
+					section.keyField,
+					key,
+					strings.Join(changedFields, ", "),
+				),
+			)
+		}
+	}
+
+	g2Config[section.table] = table
+
+	return changes, nil
+}
+
+// Find the next unused identifier in a G2_CONFIG table.
+func nextID(table []any, idField string) float64 {
+	var result float64
+
+	for _, row := range table {
+		record, isOK := row.(map[string]any)
+		if !isOK {
+			continue
+		}
+
+		if id, isOK := record[idField].(float64); isOK && id > result {
+			result = id
+		}
+	}
+
+	return result + 1
+}
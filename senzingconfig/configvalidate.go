@@ -0,0 +1,209 @@
+package senzingconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// G2_CONFIG tables that every Senzing configuration must have.
+var requiredConfigTables = []string{
+	"CFG_ATTR",
+	"CFG_DSRC",
+	"CFG_FTYPE",
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ValidateConfigDefinition function checks a Senzing configuration before it is given to Senzing.
+All problems found are reported in a single error, each prefixed by the JSON path of the problem.
+
+Input
+  - configDefinition: The Senzing configuration JSON document.
+  - compatibilityVersion: The configuration version supported by the Senzing engine.
+    If empty, the configuration version is not compared.
+*/
+func ValidateConfigDefinition(configDefinition string, compatibilityVersion string) error {
+	var configuration any
+
+	err := json.Unmarshal([]byte(configDefinition), &configuration)
+	if err != nil {
+		var syntaxError *json.SyntaxError
+		if errors.As(err, &syntaxError) {
+			line, column := offsetToLineColumn(configDefinition, syntaxError.Offset)
+
+			return wraperror.Errorf(
+				errForPackage,
+				"invalid Senzing configuration: $: not well-formed JSON at line %d, column %d: %s",
+				line,
+				column,
+				syntaxError.Error(),
+			)
+		}
+
+		return wraperror.Errorf(err, "invalid Senzing configuration: $: not well-formed JSON")
+	}
+
+	problems := validateConfiguration(configuration, compatibilityVersion)
+	if len(problems) > 0 {
+		return wraperror.Errorf(errForPackage, "invalid Senzing configuration: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Return the configuration version supported by the Senzing engine.
+func getEngineCompatibilityVersion(ctx context.Context, szAbstractFactory senzing.SzAbstractFactory) (string, error) {
+	szProduct, err := szAbstractFactory.CreateProduct(ctx)
+	if err != nil {
+		return "", wraperror.Errorf(err, "CreateProduct")
+	}
+
+	defer func() { _ = szProduct.Destroy(ctx) }()
+
+	version, err := szProduct.GetVersion(ctx)
+	if err != nil {
+		return "", wraperror.Errorf(err, "GetVersion")
+	}
+
+	versionInfo := struct {
+		CompatibilityVersion struct {
+			ConfigVersion string `json:"CONFIG_VERSION"` //nolint:tagliatelle
+		} `json:"COMPATIBILITY_VERSION"` //nolint:tagliatelle
+	}{}
+
+	err = json.Unmarshal([]byte(version), &versionInfo)
+	if err != nil {
+		return "", wraperror.Errorf(err, "json.Unmarshal: %s", version)
+	}
+
+	return versionInfo.CompatibilityVersion.ConfigVersion, nil
+}
+
+// Return a description, prefixed by its JSON path, of each problem in a parsed Senzing configuration.
+func validateConfiguration(configuration any, compatibilityVersion string) []string {
+	problems := []string{}
+
+	document, isOK := configuration.(map[string]any)
+	if !isOK {
+		return append(problems, "$: must be a JSON object")
+	}
+
+	g2Config, isOK := document["G2_CONFIG"].(map[string]any)
+	if !isOK {
+		return append(problems, "$.G2_CONFIG: missing or not a JSON object")
+	}
+
+	for _, table := range requiredConfigTables {
+		if _, isOK := g2Config[table].([]any); !isOK {
+			problems = append(problems, fmt.Sprintf("$.G2_CONFIG.%s: missing or not a JSON array", table))
+		}
+	}
+
+	problems = append(problems, validateDataSourceCodes(g2Config)...)
+	problems = append(problems, validateCompatibilityVersion(g2Config, compatibilityVersion)...)
+
+	return problems
+}
+
+// Report missing and duplicate datasource codes in CFG_DSRC.
+func validateDataSourceCodes(g2Config map[string]any) []string {
+	problems := []string{}
+	firstPaths := map[string]string{}
+
+	dataSources, _ := g2Config["CFG_DSRC"].([]any)
+	for index, row := range dataSources {
+		path := fmt.Sprintf("$.G2_CONFIG.CFG_DSRC[%d].DSRC_CODE", index)
+
+		record, isOK := row.(map[string]any)
+		if !isOK {
+			problems = append(problems, fmt.Sprintf("$.G2_CONFIG.CFG_DSRC[%d]: not a JSON object", index))
+
+			continue
+		}
+
+		dataSource, isOK := record["DSRC_CODE"].(string)
+		if !isOK || len(strings.TrimSpace(dataSource)) == 0 {
+			problems = append(problems, path+": missing datasource code")
+
+			continue
+		}
+
+		normalizedDataSource := normalizeDataSource(dataSource)
+		if firstPath, isOK := firstPaths[normalizedDataSource]; isOK {
+			problems = append(
+				problems,
+				fmt.Sprintf("%s: duplicate datasource code %s; first defined at %s", path, dataSource, firstPath),
+			)
+
+			continue
+		}
+
+		firstPaths[normalizedDataSource] = path
+	}
+
+	return problems
+}
+
+// Report a configuration version that the Senzing engine does not support.
+func validateCompatibilityVersion(g2Config map[string]any, compatibilityVersion string) []string {
+	problems := []string{}
+	path := "$.G2_CONFIG.CONFIG_BASE_VERSION.COMPATIBILITY_VERSION.CONFIG_VERSION"
+
+	baseVersion, _ := g2Config["CONFIG_BASE_VERSION"].(map[string]any)
+	versionInfo, _ := baseVersion["COMPATIBILITY_VERSION"].(map[string]any)
+
+	configVersion, isOK := versionInfo["CONFIG_VERSION"]
+	if !isOK {
+		return append(problems, path+": missing configuration version")
+	}
+
+	if len(compatibilityVersion) > 0 && fmt.Sprint(configVersion) != compatibilityVersion {
+		problems = append(
+			problems,
+			fmt.Sprintf(
+				"%s: configuration version %v does not match Senzing engine configuration version %s",
+				path,
+				configVersion,
+				compatibilityVersion,
+			),
+		)
+	}
+
+	return problems
+}
+
+// Convert a byte offset into a 1-based line and column.
+func offsetToLineColumn(content string, offset int64) (int, int) {
+	line, column := 1, 1
+
+	for index, character := range content {
+		if int64(index) >= offset-1 {
+			break
+		}
+
+		if character == '\n' {
+			line, column = line+1, 1
+		} else {
+			column++
+		}
+	}
+
+	return line, column
+}
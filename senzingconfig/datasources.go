@@ -0,0 +1,263 @@
+package senzingconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"go.yaml.in/yaml/v3"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Words that cannot be datasource codes, because tools reading Senzing output treat them specially.
+var reservedDataSourceCodes = []string{
+	"ALL",
+	"ANY",
+	"DEFAULT",
+	"NONE",
+	"NULL",
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The LoadDataSourcesFile function reads datasource codes from a file.
+
+The file is either a JSON or YAML array of datasource codes, or a list having one
+datasource code per line.  In a list, blank lines and text following "#" are ignored.
+Codes are normalized to upper case and duplicates are removed.
+
+Input
+  - dataSourcesFile: Path to the file.
+
+Output
+  - The datasource codes, in the order first seen.
+*/
+func LoadDataSourcesFile(dataSourcesFile string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Clean(dataSourcesFile))
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile: %s", dataSourcesFile)
+	}
+
+	result, err := ParseDataSources(content)
+
+	return result, wraperror.Errorf(err, "ParseDataSources: %s", dataSourcesFile)
+}
+
+/*
+The MergeDataSources function combines lists of datasource codes.
+Codes are normalized to upper case and duplicates are removed.
+
+Input
+  - dataSourceLists: Lists of datasource codes.
+
+Output
+  - The datasource codes, in the order first seen.
+*/
+func MergeDataSources(dataSourceLists ...[]string) []string {
+	result := []string{}
+
+	for _, dataSources := range dataSourceLists {
+		for _, dataSource := range dataSources {
+			normalizedDataSource := normalizeDataSource(dataSource)
+			if len(normalizedDataSource) > 0 && !slices.Contains(result, normalizedDataSource) {
+				result = append(result, normalizedDataSource)
+			}
+		}
+	}
+
+	return result
+}
+
+/*
+The ParseDataSources function parses datasource codes.
+See LoadDataSourcesFile for the accepted formats.
+
+Input
+  - content: A JSON or YAML array, or a list having one datasource code per line.
+
+Output
+  - The datasource codes, in the order first seen.
+*/
+func ParseDataSources(content []byte) ([]string, error) {
+	var (
+		dataSources []string
+		locations   []string
+	)
+
+	// A JSON document is also YAML, so one parser handles both kinds of array.
+
+	err := yaml.Unmarshal(content, &dataSources)
+	if err == nil && dataSources != nil {
+		for index := range dataSources {
+			locations = append(locations, fmt.Sprintf("item %d", index+1))
+		}
+	} else {
+		dataSources, locations = parseDataSourceLines(string(content))
+	}
+
+	problems := []string{}
+
+	for index, dataSource := range dataSources {
+		problem := getDataSourceCodeProblem(normalizeDataSource(dataSource))
+		if len(problem) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: %s", locations[index], problem))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, wraperror.Errorf(errForPackage, "invalid datasources: %s", strings.Join(problems, "; "))
+	}
+
+	return MergeDataSources(dataSources), nil
+}
+
+/*
+The ValidateDataSourceCodes function checks datasource codes against the rules for Senzing datasource codes.
+A datasource code has at most MaxDataSourceCodeLength upper case letters, digits, "_", and "-",
+starts with a letter, and is not a reserved word.
+All problems are reported in a single error, each with a suggested fix.
+
+Input
+  - dataSources: The datasource codes.
+*/
+func ValidateDataSourceCodes(dataSources []string) error {
+	problems := []string{}
+
+	for _, dataSource := range dataSources {
+		problem := getDataSourceCodeProblem(dataSource)
+		if len(problem) > 0 && !slices.Contains(problems, problem) {
+			problems = append(problems, problem)
+		}
+	}
+
+	if len(problems) > 0 {
+		return wraperror.Errorf(errForPackage, "invalid datasource codes: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Return the datasource code on each line and where it was found.
+func parseDataSourceLines(content string) ([]string, []string) {
+	var (
+		dataSources []string
+		locations   []string
+	)
+
+	for index, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		dataSources = append(dataSources, line)
+		locations = append(locations, fmt.Sprintf("line %d", index+1))
+	}
+
+	return dataSources, locations
+}
+
+// Describe what is wrong with a datasource code, including a suggested fix.  If nothing is wrong, return "".
+func getDataSourceCodeProblem(dataSource string) string {
+	if len(strings.TrimSpace(dataSource)) == 0 {
+		return "empty datasource code"
+	}
+
+	problems := []string{}
+
+	if strings.ContainsFunc(dataSource, unicode.IsLower) {
+		problems = append(problems, "contains lower case letters")
+	}
+
+	if strings.ContainsFunc(dataSource, unicode.IsSpace) {
+		problems = append(problems, "contains whitespace")
+	}
+
+	if strings.ContainsFunc(dataSource, func(character rune) bool {
+		return !isDataSourceCodeCharacter(unicode.ToUpper(character)) && !unicode.IsSpace(character)
+	}) {
+		problems = append(problems, `contains characters other than A-Z, 0-9, "_", and "-"`)
+	}
+
+	if first, _ := utf8.DecodeRuneInString(dataSource); !unicode.IsLetter(first) {
+		problems = append(problems, "does not start with a letter")
+	}
+
+	if utf8.RuneCountInString(dataSource) > MaxDataSourceCodeLength {
+		problems = append(problems, fmt.Sprintf("is longer than %d characters", MaxDataSourceCodeLength))
+	}
+
+	if slices.Contains(reservedDataSourceCodes, strings.ToUpper(dataSource)) {
+		problems = append(problems, "is a reserved word")
+	}
+
+	if len(problems) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"datasource code %q %s; try %q",
+		dataSource,
+		strings.Join(problems, ", "),
+		suggestDataSourceCode(dataSource),
+	)
+}
+
+// Characters allowed in a datasource code.
+func isDataSourceCodeCharacter(character rune) bool {
+	return (character >= 'A' && character <= 'Z') ||
+		(character >= '0' && character <= '9') ||
+		character == '_' ||
+		character == '-'
+}
+
+// Make a valid datasource code that resembles an invalid one.
+func suggestDataSourceCode(dataSource string) string {
+	var builder strings.Builder
+
+	for _, character := range strings.ToUpper(strings.TrimSpace(dataSource)) {
+		if isDataSourceCodeCharacter(character) {
+			builder.WriteRune(character)
+		} else {
+			builder.WriteRune('_')
+		}
+	}
+
+	result := builder.String()
+	for strings.Contains(result, "__") {
+		result = strings.ReplaceAll(result, "__", "_")
+	}
+
+	result = strings.Trim(result, "_-")
+
+	if first, _ := utf8.DecodeRuneInString(result); !unicode.IsLetter(first) {
+		result = strings.TrimRight("DS_"+result, "_")
+	}
+
+	if slices.Contains(reservedDataSourceCodes, result) {
+		result += "_DATA"
+	}
+
+	if len(result) > MaxDataSourceCodeLength {
+		result = strings.TrimRight(result[:MaxDataSourceCodeLength], "_-")
+	}
+
+	return result
+}
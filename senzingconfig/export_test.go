@@ -0,0 +1,18 @@
+package senzingconfig
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// SetSzAbstractFactory makes a BasicSenzingConfig use szAbstractFactory instead of creating its own.
+// The test closes szAbstractFactory.
+func SetSzAbstractFactory(senzingConfig *BasicSenzingConfig, szAbstractFactory senzing.SzAbstractFactory) {
+	senzingConfig.szAbstractFactory = szAbstractFactory
+}
+
+// DeleteConfigs deletes stored Senzing configurations as PruneConfigs does.
+func DeleteConfigs(ctx context.Context, senzingConfig *BasicSenzingConfig, configIDs []int64) error {
+	return senzingConfig.deleteConfigs(ctx, configIDs)
+}
@@ -12,23 +12,128 @@ import (
 // ----------------------------------------------------------------------------
 
 type SenzingConfig interface {
+	ApplyConfigSpec(ctx context.Context) error
+	CheckConfig(ctx context.Context, expectedFile string) (ConfigDiff, error)
+	DiffConfigs(ctx context.Context, configLocation1 string, configLocation2 string) (ConfigDiff, error)
+	ExportConfig(ctx context.Context, stripVolatileFields bool) (string, error)
+	GetConfigHistory(ctx context.Context) ([]ConfigHistoryEntry, error)
 	InitializeSenzing(ctx context.Context) error
+	PruneConfigs(ctx context.Context, keep int, pinnedConfigIDs []int64, dryRun bool) (ConfigPrunePlan, error)
 	RegisterObserver(ctx context.Context, observer observer.Observer) error
+	RenameDataSource(ctx context.Context, oldDataSource string, newDataSource string, force bool) error
+	RollbackConfig(ctx context.Context, configID int64) error
 	SetLogLevel(ctx context.Context, logLevelName string) error
 	SetObserverOrigin(ctx context.Context, origin string)
+	UnregisterDataSources(ctx context.Context, dataSources []string, force bool) error
 	UnregisterObserver(ctx context.Context, observer observer.Observer) error
+	UpgradeConfig(
+		ctx context.Context,
+		baseConfigDefinition string,
+		confirm func(configUpgradePlan ConfigUpgradePlan) bool,
+	) (ConfigUpgradePlan, error)
+}
+
+// ConfigDiff describes the differences between two Senzing configurations, grouped into sections.
+type ConfigDiff struct {
+	From     string              `json:"from"`
+	Sections []ConfigDiffSection `json:"sections"`
+	To       string              `json:"to"`
+}
+
+// ConfigDiffChange is a value that differs between two Senzing configurations.
+type ConfigDiffChange struct {
+	Field    string `json:"field,omitempty"`
+	Key      string `json:"key"`
+	NewValue any    `json:"newValue"`
+	OldValue any    `json:"oldValue"`
+}
+
+// ConfigDiffSection lists the records added, removed, and updated in a section of a ConfigDiff.
+type ConfigDiffSection struct {
+	Added   []string           `json:"added"`
+	Name    string             `json:"name"`
+	Removed []string           `json:"removed"`
+	Updated []ConfigDiffChange `json:"updated"`
+}
+
+// ConfigHistoryEntry describes a Senzing configuration stored in the database.
+type ConfigHistoryEntry struct {
+	Comment     string            `json:"comment"`
+	ConfigID    int64             `json:"configId"`
+	CreatedOn   string            `json:"createdOn"`
+	DataSources []string          `json:"dataSources"`
+	IsDefault   bool              `json:"isDefault"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// ConfigMergeConflict is a value changed differently in the current Senzing configuration and a new template.
+type ConfigMergeConflict struct {
+	CurrentValue  any    `json:"currentValue"`
+	Field         string `json:"field,omitempty"`
+	Key           string `json:"key,omitempty"`
+	Section       string `json:"section"`
+	TemplateValue any    `json:"templateValue"`
+}
+
+// ConfigPruneEntry is a stored Senzing configuration kept or removed by a prune.
+type ConfigPruneEntry struct {
+	Comment   string `json:"comment"`
+	ConfigID  int64  `json:"configId"`
+	CreatedOn string `json:"createdOn"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// ConfigPrunePlan lists the stored Senzing configurations kept and removed by a prune, newest first.
+type ConfigPrunePlan struct {
+	IsDryRun bool               `json:"isDryRun"`
+	Kept     []ConfigPruneEntry `json:"kept"`
+	Removed  []ConfigPruneEntry `json:"removed"`
+}
+
+// ConfigUpgradePlan describes the merge of a new Senzing configuration template into the default configuration.
+type ConfigUpgradePlan struct {
+	Changes         []string              `json:"changes"`
+	Conflicts       []ConfigMergeConflict `json:"conflicts"`
+	CurrentConfigID int64                 `json:"currentConfigId"`
+	NewConfigID     int64                 `json:"newConfigId,omitempty"`
 }
 
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
+// Comment of Senzing configurations created by init-database.  See FormatConfigComment.
+const DefaultConfigCommentTemplate = "{operation} by init-database at {time} with datasources: {datasources} "
+
 // Identifier of the  package found messages having the format "senzing-6502xxxx".
 const ComponentID = 6502
 
 // Log message prefix.
 const Prefix = "init-database.senzingconfig."
 
+// Number of times an update of the default Senzing configuration is attempted
+// when other processes change the default configuration at the same time.
+const MaxConfigUpdateAttempts = 5
+
+// Maximum number of characters in a datasource code.
+const MaxDataSourceCodeLength = 25
+
+// A stored Senzing configuration having this label set to "true" is never pruned.
+const PinnedConfigLabel = "pinned"
+
+// Why a stored Senzing configuration is kept by a prune.
+const (
+	ConfigPruneReasonDefault = "default" // The default configuration.
+	ConfigPruneReasonPinned  = "pinned"  // Pinned by ID or by PinnedConfigLabel.
+	ConfigPruneReasonRecent  = "recent"  // One of the most recent configurations.
+)
+
+// Values of BasicSenzingConfig.ConfigSourceMode.
+const (
+	ConfigSourceModeClone = "clone" // Replace the default configuration with the source's default configuration.
+	ConfigSourceModeMerge = "merge" // Add the source's datasources to the default configuration.
+)
+
 const (
 	OptionCallerSkip4 = 4
 	OptionCallerSkip5 = 5
@@ -55,6 +160,10 @@ var IDMessages = map[int]string{
 	22:   "Exit  " + Prefix + "InitializeSenzing(); os.Stat failed; returned (%v).",
 	23:   "Exit  " + Prefix + "InitializeSenzing(); copyFile when backing up failed; returned (%v).",
 	24:   "Exit  " + Prefix + "InitializeSenzing(); copyFile when replacing template/szConfig.json failed; returned (%v).",
+	25:   "Exit  " + Prefix + "InitializeSenzing(); getMissingDataSources failed; returned (%v).",
+	26:   "Exit  " + Prefix + "InitializeSenzing(); updateDefaultConfig failed; returned (%v).",
+	27:   "Exit  " + Prefix + "InitializeSenzing(); getEngineCompatibilityVersion failed; returned (%v).",
+	28:   "Exit  " + Prefix + "InitializeSenzing(); ValidateConfigDefinition failed; returned (%v).",
 	29:   "Exit  " + Prefix + "InitializeSenzing() returned (%v).",
 	30:   "Enter " + Prefix + "RegisterObserver(%s).",
 	31:   "Exit  " + Prefix + "RegisterObserver(%s); json.Marshal failed; returned (%v).",
@@ -80,11 +189,95 @@ var IDMessages = map[int]string{
 	60:   "Enter " + Prefix + "SetObserverOrigin(%s).",
 	61:   "Exit  " + Prefix + "SetObserverOrigin(%s); json.Marshal failed; returned (%v).",
 	69:   "Exit  " + Prefix + "SetObserverOrigin(%s).",
+	70:   "Enter " + Prefix + "ApplyConfigSpec(%s).",
+	71:   "Exit  " + Prefix + "ApplyConfigSpec(%s); json.Marshal failed; returned (%v).",
+	72:   "Exit  " + Prefix + "ApplyConfigSpec(%s); LoadConfigSpec failed; returned (%v).",
+	73:   "Exit  " + Prefix + "ApplyConfigSpec(%s); updateDefaultConfig failed; returned (%v).",
+	79:   "Exit  " + Prefix + "ApplyConfigSpec(%s) returned (%v).",
+	80:   "Enter " + Prefix + "GetConfigHistory().",
+	81:   "Exit  " + Prefix + "GetConfigHistory(); json.Marshal failed; returned (%d, %v).",
+	82:   "Exit  " + Prefix + "GetConfigHistory(); szConfigmgr.GetDefaultConfigID failed; returned (%d, %v).",
+	83:   "Exit  " + Prefix + "GetConfigHistory(); getConfigRegistry failed; returned (%d, %v).",
+	84:   "Exit  " + Prefix + "GetConfigHistory(); szConfigmgr.CreateConfigFromConfigID failed; returned (%d, %v).",
+	85:   "Exit  " + Prefix + "GetConfigHistory(); getRegisteredDataSources failed; returned (%d, %v).",
+	89:   "Exit  " + Prefix + "GetConfigHistory() returned (%d, %v).",
+	110:  "Enter " + Prefix + "RollbackConfig(%d).",
+	111:  "Exit  " + Prefix + "RollbackConfig(%d); json.Marshal failed; returned (%v).",
+	112:  "Exit  " + Prefix + "RollbackConfig(%d); getConfigRegistry failed; returned (%v).",
+	113:  "Exit  " + Prefix + "RollbackConfig(%d); Senzing configuration does not exist; returned (%v).",
+	114:  "Exit  " + Prefix + "RollbackConfig(%d); szConfigmgr.GetDefaultConfigID failed; returned (%v).",
+	115:  "Exit  " + Prefix + "RollbackConfig(%d); szConfigmgr.ReplaceDefaultConfigID failed; returned (%v).",
+	119:  "Exit  " + Prefix + "RollbackConfig(%d) returned (%v).",
+	120:  "Enter " + Prefix + "ExportConfig(%t).",
+	121:  "Exit  " + Prefix + "ExportConfig(%t); json.Marshal failed; returned (%d, %v).",
+	122:  "Exit  " + Prefix + "ExportConfig(%t); szConfigmgr.GetDefaultConfigID failed; returned (%d, %v).",
+	123:  "Exit  " + Prefix + "ExportConfig(%t); szConfigmgr.CreateConfigFromConfigID failed; returned (%d, %v).",
+	124:  "Exit  " + Prefix + "ExportConfig(%t); szConfig.Export failed; returned (%d, %v).",
+	125:  "Exit  " + Prefix + "ExportConfig(%t); removeVolatileFields failed; returned (%d, %v).",
+	126:  "Exit  " + Prefix + "ExportConfig(%t); getConfigRegistry failed; returned (%d, %v).",
+	127:  "Exit  " + Prefix + "ExportConfig(%t); addConfigMetadata failed; returned (%d, %v).",
+	129:  "Exit  " + Prefix + "ExportConfig(%t) returned (%d, %v).",
+	130:  "Enter " + Prefix + "UnregisterDataSources(%v, %t).",
+	131:  "Exit  " + Prefix + "UnregisterDataSources(%v); json.Marshal failed; returned (%v).",
+	132:  "Exit  " + Prefix + "UnregisterDataSources(%v); updateDefaultConfig failed; returned (%v).",
+	133:  "Exit  " + Prefix + "UnregisterDataSources(%v); verifyNoRecords failed; returned (%v).",
+	134:  "Exit  " + Prefix + "UnregisterDataSources(%v); szConfig.UnregisterDataSource failed; returned (%v).",
+	135:  "Exit  " + Prefix + "UnregisterDataSources(%v); saveDefaultConfig failed; returned (%v).",
+	139:  "Exit  " + Prefix + "UnregisterDataSources(%v) returned (%v).",
+	140:  "Enter " + Prefix + "RenameDataSource(%s, %s, %t).",
+	141:  "Exit  " + Prefix + "RenameDataSource(%s, %s); json.Marshal failed; returned (%v).",
+	142:  "Exit  " + Prefix + "RenameDataSource(%s, %s); invalid datasource; returned (%v).",
+	143:  "Exit  " + Prefix + "RenameDataSource(%s, %s); updateDefaultConfig failed; returned (%v).",
+	144:  "Exit  " + Prefix + "RenameDataSource(%s, %s); verifyNoRecords failed; returned (%v).",
+	145:  "Exit  " + Prefix + "RenameDataSource(%s, %s); szConfig.RegisterDataSource failed; returned (%v).",
+	146:  "Exit  " + Prefix + "RenameDataSource(%s, %s); szConfig.UnregisterDataSource failed; returned (%v).",
+	147:  "Exit  " + Prefix + "RenameDataSource(%s, %s); saveDefaultConfig failed; returned (%v).",
+	149:  "Exit  " + Prefix + "RenameDataSource(%s, %s) returned (%v).",
+	150:  "Exit  " + Prefix + "InitializeSenzing(); readConfigSource failed; returned (%v).",
+	151:  "Exit  " + Prefix + "InitializeSenzing(); installConfigSource failed; returned (%v).",
+	160:  "Enter " + Prefix + "UpgradeConfig().",
+	161:  "Exit  " + Prefix + "UpgradeConfig(); json.Marshal failed; returned (%v).",
+	162:  "Exit  " + Prefix + "UpgradeConfig(); szConfigmgr.GetDefaultConfigID failed; returned (%v).",
+	163:  "Exit  " + Prefix + "UpgradeConfig(); szConfigmgr.CreateConfigFromConfigID failed; returned (%v).",
+	164:  "Exit  " + Prefix + "UpgradeConfig(); szConfigmgr.CreateConfigFromTemplate failed; returned (%v).",
+	165:  "Exit  " + Prefix + "UpgradeConfig(); MergeConfigs failed; returned (%v).",
+	166:  "Exit  " + Prefix + "UpgradeConfig(); szConfigmgr.RegisterConfig failed; returned (%v).",
+	167:  "Exit  " + Prefix + "UpgradeConfig(); szConfigmgr.ReplaceDefaultConfigID failed; returned (%v).",
+	169:  "Exit  " + Prefix + "UpgradeConfig() returned (%v).",
+	170:  "Enter " + Prefix + "DiffConfigs(%s, %s).",
+	171:  "Exit  " + Prefix + "DiffConfigs(%s, %s); json.Marshal failed; returned (%v).",
+	172:  "Exit  " + Prefix + "DiffConfigs(%s, %s); readConfigLocation failed; returned (%v).",
+	173:  "Exit  " + Prefix + "DiffConfigs(%s, %s); szConfigmgr.CreateConfigFromConfigID failed; returned (%v).",
+	174:  "Exit  " + Prefix + "DiffConfigs(%s, %s); szConfig.Export failed; returned (%v).",
+	175:  "Exit  " + Prefix + "DiffConfigs(%s, %s); CompareConfigs failed; returned (%v).",
+	179:  "Exit  " + Prefix + "DiffConfigs(%s, %s) returned (%v).",
+	180:  "Enter " + Prefix + "PruneConfigs(%d, %v, %t).",
+	181:  "Exit  " + Prefix + "PruneConfigs(%d, %v, %t); json.Marshal failed; returned (%v).",
+	182:  "Exit  " + Prefix + "PruneConfigs(%d, %v, %t); invalid number to keep; returned (%v).",
+	183:  "Exit  " + Prefix + "PruneConfigs(%d, %v, %t); szConfigmgr.GetDefaultConfigID failed; returned (%v).",
+	184:  "Exit  " + Prefix + "PruneConfigs(%d, %v, %t); getConfigRegistry failed; returned (%v).",
+	185:  "Exit  " + Prefix + "PruneConfigs(%d, %v, %t); default Senzing configuration would be removed; returned (%v).",
+	186:  "Exit  " + Prefix + "PruneConfigs(%d, %v, %t); deleteConfigs failed; returned (%v).",
+	189:  "Exit  " + Prefix + "PruneConfigs(%d, %v, %t) returned (%v).",
+	190:  "Enter " + Prefix + "CheckConfig(%s).",
+	191:  "Exit  " + Prefix + "CheckConfig(%s); json.Marshal failed; returned (%v).",
+	192:  "Exit  " + Prefix + "CheckConfig(%s); os.ReadFile failed; returned (%v).",
+	193:  "Exit  " + Prefix + "CheckConfig(%s); ParseDataSources failed; returned (%v).",
+	194:  "Exit  " + Prefix + "CheckConfig(%s); szConfigmgr.GetDefaultConfigID failed; returned (%v).",
+	195:  "Exit  " + Prefix + "CheckConfig(%s); szConfigmgr.CreateConfigFromConfigID failed; returned (%v).",
+	196:  "Exit  " + Prefix + "CheckConfig(%s); szConfig.Export failed; returned (%v).",
+	197:  "Exit  " + Prefix + "CheckConfig(%s); CompareConfigs failed; returned (%v).",
+	198:  "Exit  " + Prefix + "CheckConfig(%s); getRegisteredDataSources failed; returned (%v).",
+	199:  "Exit  " + Prefix + "CheckConfig(%s) for Senzing configuration %d returned (%v).",
 	1001: Prefix + "InitializeSenzing parameters: %+v",
 	1002: Prefix + "RegisterObserver parameters: %+v",
 	1003: Prefix + "SetLogLevel parameters: %+v",
 	1004: Prefix + "SetObserverOrigin parameters: %+v",
 	1005: Prefix + "UnregisterObserver parameters: %+v",
+	1006: Prefix + "ApplyConfigSpec parameters: %+v",
+	1007: Prefix + "GetConfigHistory parameters: %+v",
+	1008: Prefix + "RollbackConfig parameters: %+v",
+	1009: Prefix + "ExportConfig parameters: %+v",
 	1011: Prefix + "Initialize(); json.Marshal failed; Error: %v.",
 	1012: Prefix + "Initialize(); senzingConfig.getDependentServices failed; Error: %v.",
 	1013: Prefix + "Initialize(); szConfigmgr.GetDefaultConfigID failed; Error: %v.",
@@ -99,6 +292,10 @@ var IDMessages = map[int]string{
 	1022: Prefix + "Initialize(); os.Stat failed; Error: %v.",
 	1023: Prefix + "Initialize(); copyFile when backing up failed; Error: %v.",
 	1024: Prefix + "Initialize(); copyFile when replacing template/szConfig.json failed; Error: %v.",
+	1025: Prefix + "Initialize(); getMissingDataSources failed; Error: %v.",
+	1026: Prefix + "Initialize(); updateDefaultConfig failed; Error: %v.",
+	1027: Prefix + "Initialize(); getEngineCompatibilityVersion failed; Error: %v.",
+	1028: Prefix + "Initialize(); ValidateConfigDefinition failed; Error: %v.",
 	1031: Prefix + "RegisterObserver(%s); json.Marshal failed; returned (%v).",
 	1032: Prefix + "RegisterObserver(%s); senzingConfig.observers.RegisterObserver failed; returned (%v).",
 	1033: Prefix + "RegisterObserver(%s); senzingConfig.getDependentServices failed; returned (%v).",
@@ -114,12 +311,94 @@ var IDMessages = map[int]string{
 	1052: Prefix + "UnregisterObserver(%s); szConfig.UnregisterObserver failed; returned (%v).",
 	1053: Prefix + "UnregisterObserver(%s); szConfigmgr.UnregisterObserver failed; returned (%v).",
 	1054: Prefix + "UnregisterObserver(%s); senzingConfig.observers.UnregisterObserver failed; returned (%v).",
+	1071: Prefix + "ApplyConfigSpec(); json.Marshal failed; Error: %v.",
+	1072: Prefix + "ApplyConfigSpec(); LoadConfigSpec failed; Error: %v.",
+	1073: Prefix + "ApplyConfigSpec(); updateDefaultConfig failed; Error: %v.",
+	1081: Prefix + "GetConfigHistory(); json.Marshal failed; Error: %v.",
+	1082: Prefix + "GetConfigHistory(); szConfigmgr.GetDefaultConfigID failed; Error: %v.",
+	1083: Prefix + "GetConfigHistory(); getConfigRegistry failed; Error: %v.",
+	1084: Prefix + "GetConfigHistory(); szConfigmgr.CreateConfigFromConfigID failed; Error: %v.",
+	1085: Prefix + "GetConfigHistory(); getRegisteredDataSources failed; Error: %v.",
+	1111: Prefix + "RollbackConfig(%d); json.Marshal failed; Error: %v.",
+	1112: Prefix + "RollbackConfig(%d); getConfigRegistry failed; Error: %v.",
+	1113: Prefix + "RollbackConfig(%d); Senzing configuration does not exist; Error: %v.",
+	1114: Prefix + "RollbackConfig(%d); szConfigmgr.GetDefaultConfigID failed; Error: %v.",
+	1115: Prefix + "RollbackConfig(%d); szConfigmgr.ReplaceDefaultConfigID failed; Error: %v.",
+	1121: Prefix + "ExportConfig(); json.Marshal failed; Error: %v.",
+	1122: Prefix + "ExportConfig(); szConfigmgr.GetDefaultConfigID failed; Error: %v.",
+	1123: Prefix + "ExportConfig(); szConfigmgr.CreateConfigFromConfigID failed; Error: %v.",
+	1124: Prefix + "ExportConfig(); szConfig.Export failed; Error: %v.",
+	1125: Prefix + "ExportConfig(); removeVolatileFields failed; Error: %v.",
+	1126: Prefix + "ExportConfig(); getConfigRegistry failed; Error: %v.",
+	1127: Prefix + "ExportConfig(); addConfigMetadata failed; Error: %v.",
+	1130: Prefix + "UnregisterDataSources parameters: %+v",
+	1131: Prefix + "UnregisterDataSources(%v); json.Marshal failed; Error: %v.",
+	1132: Prefix + "UnregisterDataSources(%v); updateDefaultConfig failed; Error: %v.",
+	1140: Prefix + "RenameDataSource parameters: %+v",
+	1141: Prefix + "RenameDataSource(%s, %s); json.Marshal failed; Error: %v.",
+	1142: Prefix + "RenameDataSource(%s, %s); invalid datasource; Error: %v.",
+	1143: Prefix + "RenameDataSource(%s, %s); updateDefaultConfig failed; Error: %v.",
+	1150: Prefix + "Initialize(); readConfigSource failed; Error: %v.",
+	1151: Prefix + "Initialize(); installConfigSource failed; Error: %v.",
+	1160: Prefix + "UpgradeConfig parameters: %+v",
+	1161: Prefix + "UpgradeConfig(); json.Marshal failed; Error: %v.",
+	1162: Prefix + "UpgradeConfig(); szConfigmgr.GetDefaultConfigID failed; Error: %v.",
+	1163: Prefix + "UpgradeConfig(); szConfigmgr.CreateConfigFromConfigID failed; Error: %v.",
+	1164: Prefix + "UpgradeConfig(); szConfigmgr.CreateConfigFromTemplate failed; Error: %v.",
+	1165: Prefix + "UpgradeConfig(); MergeConfigs failed; Error: %v.",
+	1166: Prefix + "UpgradeConfig(); szConfigmgr.RegisterConfig failed; Error: %v.",
+	1167: Prefix + "UpgradeConfig(); szConfigmgr.ReplaceDefaultConfigID failed; Error: %v.",
+	1170: Prefix + "DiffConfigs parameters: %+v",
+	1171: Prefix + "DiffConfigs(); json.Marshal failed; Error: %v.",
+	1172: Prefix + "DiffConfigs(); readConfigLocation failed; Error: %v.",
+	1173: Prefix + "DiffConfigs(); szConfigmgr.CreateConfigFromConfigID failed; Error: %v.",
+	1174: Prefix + "DiffConfigs(); szConfig.Export failed; Error: %v.",
+	1175: Prefix + "DiffConfigs(); CompareConfigs failed; Error: %v.",
+	1180: Prefix + "PruneConfigs parameters: %+v",
+	1181: Prefix + "PruneConfigs(); json.Marshal failed; Error: %v.",
+	1182: Prefix + "PruneConfigs(); invalid number to keep; Error: %v.",
+	1183: Prefix + "PruneConfigs(); szConfigmgr.GetDefaultConfigID failed; Error: %v.",
+	1184: Prefix + "PruneConfigs(); getConfigRegistry failed; Error: %v.",
+	1185: Prefix + "PruneConfigs(); default Senzing configuration would be removed; Error: %v.",
+	1186: Prefix + "PruneConfigs(); deleteConfigs failed; Error: %v.",
+	1190: Prefix + "CheckConfig parameters: %+v",
+	1191: Prefix + "CheckConfig(); json.Marshal failed; Error: %v.",
+	1192: Prefix + "CheckConfig(); os.ReadFile failed; Error: %v.",
+	1193: Prefix + "CheckConfig(); ParseDataSources failed; Error: %v.",
+	1194: Prefix + "CheckConfig(); szConfigmgr.GetDefaultConfigID failed; Error: %v.",
+	1195: Prefix + "CheckConfig(); szConfigmgr.CreateConfigFromConfigID failed; Error: %v.",
+	1196: Prefix + "CheckConfig(); szConfig.Export failed; Error: %v.",
+	1197: Prefix + "CheckConfig(); CompareConfigs failed; Error: %v.",
+	1198: Prefix + "CheckConfig(); getRegisteredDataSources failed; Error: %v.",
 	2001: "Added Datasource: %s",
 	2002: "No new Senzing configuration created.  One already exists (%d).",
 	2003: "Created Senzing configuration: %d named: %s",
 	2004: "Copied file %s to %s",
 	2005: "%s and %s have same content.  No file manipulation needed.",
 	2006: "Default Config ID: %d",
+	2007: "Senzing configuration %d is up to date. Datasources already registered: %s",
+	2008: "Senzing configuration %d already matches %s.  No new Senzing configuration created.",
+	2009: "Applied %s.  Created Senzing configuration %d with %d change(s).",
+	2010: "Config spec change: %s",
+	2011: "Rolled back default Senzing configuration from %d to %d",
+	2012: "Senzing configuration %d is already the default.  No rollback needed.",
+	2013: "Removed Datasource: %s",
+	2014: "Renamed Datasource %s to %s in Senzing configuration %d",
+	2015: "Installed Senzing configuration from %s (%s) as Senzing configuration %d",
+	2016: "Senzing configuration %d is up to date with %s (%s).  No new Senzing configuration created.",
+	2017: "Senzing configuration %d already includes the Senzing configuration template.  No upgrade needed.",
+	2018: "Upgraded Senzing configuration %d to %d with %d change(s)",
+	2019: "Upgrade of Senzing configuration %d not confirmed.  No new Senzing configuration created.",
+	2020: "Removed Senzing configuration %d created on %s",
+	2021: "Dry run.  Would remove Senzing configuration %d created on %s",
+	2022: "Pruned %d Senzing configuration(s).  Kept %d.",
+	2023: "Senzing configuration %d matches %s",
+	2024: "Installed %s as the Senzing configuration template %s",
+	3001: "Datasource %s is not registered.  Nothing to remove.",
+	3002: "Removing datasource %s, which still has %d record(s), because force was requested.",
+	3003: "Default Senzing configuration %d was changed by another process (attempt %d of %d). Senzing configuration %d was registered but not made the default; it remains in the configuration registry until removed by config prune.",
+	3004: "Upgrade conflict in %s %s %s.  Keeping current value.",
+	3005: "Senzing configuration %d differs from %s",
 	4001: "When comparing %s and %s, an error occurred. Assuming files not equal.",
 	5001: "File does not exist: %s [SENZING_TOOLS_ENGINE_CONFIGURATION_FILE]",
 	5002: "Could not backup %s to %s",
@@ -130,6 +409,19 @@ var IDMessages = map[int]string{
 	8004: Prefix + "SetLogLevel",
 	8005: Prefix + "SetObserverOrigin",
 	8006: Prefix + "UnregisterObserver",
+	8007: Prefix + "ApplyConfigSpec",
+	8008: Prefix + "GetConfigHistory",
+	8009: Prefix + "RollbackConfig",
+	8010: Prefix + "ExportConfig",
+	8011: Prefix + "UnregisterDataSources",
+	8012: Prefix + "RenameDataSource",
+	8013: Prefix + "replaceDefaultConfig - conflict",
+	8014: Prefix + "InitializeSenzing - config source",
+	8015: Prefix + "InitializeSenzing - engine configuration file installed",
+	8016: Prefix + "UpgradeConfig",
+	8017: Prefix + "DiffConfigs",
+	8018: Prefix + "PruneConfigs",
+	8019: Prefix + "CheckConfig",
 }
 
 // Status strings for specific messages.
@@ -2,14 +2,20 @@ package senzingconfig
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
+	"github.com/senzing-garage/go-databasing/connector"
+	helpersettings "github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/go-helpers/settingsparser"
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/notifier"
@@ -17,6 +23,7 @@ import (
 	"github.com/senzing-garage/go-observing/subject"
 	"github.com/senzing-garage/go-sdk-abstract-factory/szfactorycreator"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"google.golang.org/grpc"
 )
 
@@ -26,6 +33,11 @@ import (
 
 // BasicSenzingConfig is the default implementation of the SenzingConfig interface.
 type BasicSenzingConfig struct {
+	ConfigCommentTemplate string            `json:"configCommentTemplate,omitempty"`
+	ConfigLabels          map[string]string `json:"configLabels,omitempty"`
+	ConfigSource          string            `json:"configSource,omitempty"`
+	ConfigSourceMode      string            `json:"configSourceMode,omitempty"`
+	ConfigSpecFile        string            `json:"configSpecFile,omitempty"`
 	DataSources           []string          `json:"dataSources,omitempty"`
 	GrpcDialOptions       []grpc.DialOption `json:"grpcDialOptions,omitempty"`
 	GrpcTarget            string            `json:"grpcTarget,omitempty"`
@@ -34,19 +46,50 @@ type BasicSenzingConfig struct {
 	SenzingConfigJSONFile string            `json:"senzingConfigJsonFile,omitempty"`
 	SenzingVerboseLogging int64             `json:"senzingVerboseLogging,omitempty"`
 
-	isTrace                    bool
-	logger                     logging.Logging
-	logLevel                   string
-	observerOrigin             string
-	observers                  subject.Subject
-	szAbstractFactorySingleton senzing.SzAbstractFactory
-	szAbstractFactorySyncOnce  sync.Once
+	isTrace           bool
+	logger            logging.Logging
+	logLevel          string
+	observerOrigin    string
+	observers         subject.Subject
+	szAbstractFactory senzing.SzAbstractFactory
+}
+
+// callerOwnedAbstractFactory wraps an abstract factory that methods use but do not close.
+type callerOwnedAbstractFactory struct {
+	senzing.SzAbstractFactory
+}
+
+// dataSourceRegistry is the JSON returned by SzConfig.GetDataSourceRegistry.
+type dataSourceRegistry struct {
+	DataSources []struct {
+		DsrcCode string `json:"DSRC_CODE"` //nolint:tagliatelle
+		DsrcID   int64  `json:"DSRC_ID"`   //nolint:tagliatelle
+	} `json:"DATA_SOURCES"` //nolint:tagliatelle
+}
+
+// configRegistry is the JSON returned by SzConfigManager.GetConfigRegistry.
+type configRegistry struct {
+	Configs []configRegistryEntry `json:"CONFIGS"` //nolint:tagliatelle
+}
+
+type configRegistryEntry struct {
+	ConfigComments string `json:"CONFIG_COMMENTS"` //nolint:tagliatelle
+	ConfigID       int64  `json:"CONFIG_ID"`       //nolint:tagliatelle
+	SysCreateDt    string `json:"SYS_CREATE_DT"`   //nolint:tagliatelle
 }
 
 // ----------------------------------------------------------------------------
 // Variables
 // ----------------------------------------------------------------------------
 
+// Fields of G2_CONFIG.CONFIG_BASE_VERSION that change whenever the Senzing engine is upgraded.
+var volatileConfigBaseVersionFields = []string{
+	"BUILD_DATE",
+	"BUILD_NUMBER",
+	"BUILD_VERSION",
+	"VERSION",
+}
+
 var debugOptions = []interface{}{
 	&logging.OptionCallerSkip{Value: OptionCallerSkip5},
 }
@@ -55,27 +98,33 @@ var traceOptions = []interface{}{
 	&logging.OptionCallerSkip{Value: OptionCallerSkip5},
 }
 
+// Delay before retrying an update of the default Senzing configuration. Multiplied by the attempt number.
+var configUpdateRetryDelay = 100 * time.Millisecond
+
+// Location of the Senzing configuration template, relative to the Senzing resource path.
+var engineConfigurationTemplate = "templates/g2config.json"
+
 // ----------------------------------------------------------------------------
 // Interface methods
 // ----------------------------------------------------------------------------
 
 /*
-The InitializeSenzing method adds the Senzing default configuration to databases.
+The ApplyConfigSpec method makes the default Senzing configuration match the
+declarative specification in ConfigSpecFile.
+Only differences are applied.  If there are none, no new configuration is created.
 
 Input
   - ctx: A context to control lifecycle.
 */
-func (senzingConfig *BasicSenzingConfig) InitializeSenzing(ctx context.Context) error {
+func (senzingConfig *BasicSenzingConfig) ApplyConfigSpec(ctx context.Context) error {
 	var err error
 
 	var configID int64
 
-	entryTime := time.Now()
-
 	// Prolog.
 
 	debugMessageNumber := 0
-	traceExitMessageNumber := 29
+	traceExitMessageNumber := 79
 
 	if senzingConfig.getLogger().IsDebug() {
 		// If DEBUG, log error exit.
@@ -88,130 +137,94 @@ func (senzingConfig *BasicSenzingConfig) InitializeSenzing(ctx context.Context)
 		// If TRACE, Log on entry/exit.
 
 		if senzingConfig.getLogger().IsTrace() {
-			senzingConfig.traceEntry(10, configID)
+			entryTime := time.Now()
 
-			defer func() { senzingConfig.traceExit(traceExitMessageNumber, configID, err, time.Since(entryTime)) }()
+			senzingConfig.traceEntry(70, senzingConfig.ConfigSpecFile)
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, senzingConfig.ConfigSpecFile, err, time.Since(entryTime))
+			}()
 		}
 
 		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
 
 		asJSON, err := json.Marshal(senzingConfig)
 		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 11, 1011
+			traceExitMessageNumber, debugMessageNumber = 71, 1071
 
 			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
 		}
 
-		senzingConfig.log(1001, senzingConfig, string(asJSON))
+		senzingConfig.log(1006, senzingConfig, string(asJSON))
 	}
 
-	// Create Senzing objects.
-
-	szAbstractFactory := senzingConfig.getAbstractFactory(ctx)
-
-	defer func() { szAbstractFactory.Close(ctx) }()
+	// Read the specification.
 
-	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	configSpec, err := LoadConfigSpec(senzingConfig.ConfigSpecFile)
 	if err != nil {
-		return wraperror.Errorf(err, "CreateConfigManager")
-	}
-
-	defer func() { _ = szConfigManager.Destroy(ctx) }()
-
-	// If a Senzing configuration file is specified, use it.
-
-	if len(senzingConfig.SenzingConfigJSONFile) > 0 {
-		configDefinition, err1 := fileToString(ctx, senzingConfig.SenzingConfigJSONFile)
-		if err1 != nil {
-			traceExitMessageNumber, debugMessageNumber = 99, 1999
-
-			return wraperror.Errorf(err1, "fileToString: %s", senzingConfig.SenzingConfigJSONFile)
-		}
-
-		szConfig, err2 := szConfigManager.CreateConfigFromString(ctx, configDefinition)
-		if err2 != nil {
-			traceExitMessageNumber, debugMessageNumber = 99, 1999
-
-			return wraperror.Errorf(err2, "CreateConfigFromString: %s", configDefinition)
-		}
-
-		configID, err = senzingConfig.makeDefaultConfig(ctx, szAbstractFactory, szConfig)
-		senzingConfig.log(2006, configID)
-
-		traceExitMessageNumber, debugMessageNumber = 99, 999
+		traceExitMessageNumber, debugMessageNumber = 72, 1072
 
-		return wraperror.Errorf(err, "makeDefaultConfig")
+		return wraperror.Errorf(err, "LoadConfigSpec: %s", senzingConfig.ConfigSpecFile)
 	}
 
-	// Determine if configuration already exists. If so, return.
+	// Create Senzing objects.
 
-	configID, err = szConfigManager.GetDefaultConfigID(ctx)
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
 	if err != nil {
-		traceExitMessageNumber, debugMessageNumber = 13, 1013
-
-		return wraperror.Errorf(err, "GetDefaultConfigID")
+		return wraperror.Errorf(err, "getAbstractFactory")
 	}
 
-	if configID != 0 {
-		if senzingConfig.observers != nil {
-			go func() {
-				details := map[string]string{}
-				notifier.Notify(
-					ctx,
-					senzingConfig.observers,
-					senzingConfig.observerOrigin,
-					ComponentID,
-					8001,
-					err,
-					details,
-				)
-			}()
-		}
-
-		if len(senzingConfig.DataSources) > 0 {
-			szConfig, err2 := szConfigManager.CreateConfigFromConfigID(ctx, configID)
-			if err2 != nil {
-				traceExitMessageNumber, debugMessageNumber = 99, 1999
-
-				return wraperror.Errorf(err2, "CreateConfigFromConfigID: %d", configID)
-			}
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
 
-			configID, err = senzingConfig.makeDefaultConfig(ctx, szAbstractFactory, szConfig)
-		}
+	// The specification is applied on top of the current default configuration.
 
-		senzingConfig.log(2002, configID)
+	var (
+		changes   []string
+		isChanged bool
+	)
 
-		traceExitMessageNumber, debugMessageNumber = 14, 0 // debugMessageNumber=0 because it's not an error.
+	configID, isChanged, err = senzingConfig.updateDefaultConfig(
+		ctx,
+		szAbstractFactory,
+		func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error) {
+			configDefinition, specChanges, err := applyConfigSpecToSzConfig(ctx, szConfig, configSpec)
+			changes = specChanges
+			configComment := senzingConfig.formatConfigComment(
+				"Applied "+filepath.Base(senzingConfig.ConfigSpecFile),
+				nil,
+				configDefinition,
+			)
+
+			return configDefinition, configComment, err
+		},
+	)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 73, 1073
 
-		return wraperror.Errorf(err, "ConfigID: %d", configID)
+		return wraperror.Errorf(err, "updateDefaultConfig")
 	}
 
-	// If no configuration file specified, install the template.
-
-	if len(senzingConfig.SenzingConfigJSONFile) == 0 {
-		szConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
-		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 99, 1999
-
-			return wraperror.Errorf(err, "CreateConfigFromTemplate")
-		}
-
-		configID, err = senzingConfig.makeDefaultConfig(ctx, szAbstractFactory, szConfig)
-		senzingConfig.log(2006, configID)
+	if !isChanged {
+		senzingConfig.log(2008, configID, senzingConfig.ConfigSpecFile)
 
-		traceExitMessageNumber, debugMessageNumber = 999, 999
+		return nil
+	}
 
-		return wraperror.Errorf(err, "makeDefaultConfig")
+	for _, change := range changes {
+		senzingConfig.log(2010, change)
 	}
 
-	// Notify observers.
+	senzingConfig.log(2009, senzingConfig.ConfigSpecFile, configID, len(changes))
 
-	senzingConfig.log(2003, configID)
+	// Notify observers.
 
 	if senzingConfig.observers != nil {
 		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8002, err, details)
+			details := map[string]string{
+				"configID":       strconv.FormatInt(configID, 10),
+				"configSpecFile": senzingConfig.ConfigSpecFile,
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8007, err, details)
 		}()
 	}
 
@@ -219,23 +232,31 @@ func (senzingConfig *BasicSenzingConfig) InitializeSenzing(ctx context.Context)
 }
 
 /*
-The RegisterObserver method adds the observer to the list of observers notified.
+The CheckConfig method compares the default Senzing configuration with an expectation,
+without changing anything.
+
+The expected file is either Senzing configuration JSON (see ExportConfig),
+compared as in DiffConfigs, or a list of datasources (see LoadDataSourcesFile),
+compared with the registered datasources as in CompareDataSources.
 
 Input
   - ctx: A context to control lifecycle.
-  - observer: The observer to be added.
-*/
-func (senzingConfig *BasicSenzingConfig) RegisterObserver(ctx context.Context, observer observer.Observer) error {
-	var err error
+  - expectedFile: Path to the file of Senzing configuration JSON or datasources.
 
-	if observer == nil {
-		return err
-	}
+Output
+  - The differences from the expectation to the default configuration.  See HasConfigDifferences.
+*/
+func (senzingConfig *BasicSenzingConfig) CheckConfig(ctx context.Context, expectedFile string) (ConfigDiff, error) {
+	var (
+		err      error
+		configID int64
+		result   ConfigDiff
+	)
 
 	// Prolog.
 
 	debugMessageNumber := 0
-	traceExitMessageNumber := 39
+	traceExitMessageNumber := 199
 
 	if senzingConfig.getLogger().IsDebug() {
 		// If DEBUG, log error exit.
@@ -250,10 +271,10 @@ func (senzingConfig *BasicSenzingConfig) RegisterObserver(ctx context.Context, o
 		if senzingConfig.getLogger().IsTrace() {
 			entryTime := time.Now()
 
-			senzingConfig.traceEntry(30, observer.GetObserverID(ctx))
+			senzingConfig.traceEntry(190, expectedFile)
 
 			defer func() {
-				senzingConfig.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+				senzingConfig.traceExit(traceExitMessageNumber, expectedFile, configID, err, time.Since(entryTime))
 			}()
 		}
 
@@ -261,128 +282,171 @@ func (senzingConfig *BasicSenzingConfig) RegisterObserver(ctx context.Context, o
 
 		asJSON, err := json.Marshal(senzingConfig)
 		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 31, 1031
+			traceExitMessageNumber, debugMessageNumber = 191, 1191
 
-			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
 		}
 
-		senzingConfig.log(1002, senzingConfig, string(asJSON))
+		senzingConfig.log(1190, senzingConfig, string(asJSON))
 	}
 
-	// Create empty list of observers.
+	// Read the expectation.
 
-	if senzingConfig.observers == nil {
-		senzingConfig.observers = &subject.SimpleSubject{}
+	expectedContent, err := os.ReadFile(filepath.Clean(expectedFile))
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 192, 1192
+
+		return result, wraperror.Errorf(err, "os.ReadFile: %s", expectedFile)
 	}
 
-	// Notify observers.
+	isExpectedConfig := isConfigDefinition(expectedContent)
 
-	go func() {
-		details := map[string]string{
-			"observerID": observer.GetObserverID(ctx),
+	var expectedDataSources []string
+
+	if !isExpectedConfig {
+		expectedDataSources, err = ParseDataSources(expectedContent)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 193, 1193
+
+			return result, wraperror.Errorf(err, "ParseDataSources: %s", expectedFile)
 		}
-		notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8003, err, details)
-	}()
+	}
 
-	return wraperror.Errorf(err, wraperror.NoMessage)
-}
+	// Create Senzing objects.
 
-/*
-The SetLogLevel method sets the level of logging.
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getAbstractFactory")
+	}
 
-Input
-  - ctx: A context to control lifecycle.
-  - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
-*/
-func (senzingConfig *BasicSenzingConfig) SetLogLevel(ctx context.Context, logLevelName string) error {
-	var err error
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
 
-	// Prolog.
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "CreateConfigManager")
+	}
 
-	debugMessageNumber := 0
-	traceExitMessageNumber := 49
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
 
-	if senzingConfig.getLogger().IsDebug() {
-		// If DEBUG, log error exit.
-		defer func() {
-			if debugMessageNumber > 0 {
-				senzingConfig.debug(debugMessageNumber, err)
-			}
-		}()
+	configID, err = szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 194, 1194
 
-		// If TRACE, Log on entry/exit.
+		return result, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
 
-		if senzingConfig.getLogger().IsTrace() {
-			entryTime := time.Now()
+	if configID == 0 {
+		traceExitMessageNumber, debugMessageNumber = 194, 1194
 
-			senzingConfig.traceEntry(40, logLevelName)
+		return result, wraperror.Errorf(errForPackage, "no default Senzing configuration to check")
+	}
 
-			defer func() {
-				senzingConfig.traceExit(traceExitMessageNumber, logLevelName, err, time.Since(entryTime))
-			}()
-		}
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 195, 1195
 
-		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+		return result, wraperror.Errorf(err, "CreateConfigFromConfigID: %d", configID)
+	}
 
-		asJSON, err := json.Marshal(senzingConfig)
+	// Compare the default configuration with the expectation.
+
+	if isExpectedConfig {
+		var configDefinition string
+
+		configDefinition, err = szConfig.Export(ctx)
 		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 41, 1041
+			traceExitMessageNumber, debugMessageNumber = 196, 1196
 
-			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+			return result, wraperror.Errorf(err, "Export: %d", configID)
 		}
 
-		senzingConfig.log(1003, senzingConfig, string(asJSON))
-	}
-
-	// Verify value of logLevelName.
+		result, err = CompareConfigs(string(expectedContent), configDefinition)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 197, 1197
 
-	if !logging.IsValidLogLevelName(logLevelName) {
-		traceExitMessageNumber, debugMessageNumber = 42, 1042
+			return result, wraperror.Errorf(err, "CompareConfigs")
+		}
+	} else {
+		var (
+			builtInDataSources    []string
+			registeredDataSources []string
+			szTemplateConfig      senzing.SzConfig
+		)
+
+		registeredDataSources, err = getRegisteredDataSources(ctx, szConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 198, 1198
 
-		return wraperror.Errorf(errForPackage, "invalid error level: %s", logLevelName)
-	}
+			return result, wraperror.Errorf(err, "getRegisteredDataSources")
+		}
 
-	// Set senzingConfig log level.
+		szTemplateConfig, err = szConfigManager.CreateConfigFromTemplate(ctx)
+		if err != nil {
+			return result, wraperror.Errorf(err, "CreateConfigFromTemplate")
+		}
 
-	senzingConfig.logLevel = logLevelName
+		builtInDataSources, err = getRegisteredDataSources(ctx, szTemplateConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 198, 1198
 
-	err = senzingConfig.getLogger().SetLogLevel(logLevelName)
-	if err != nil {
-		traceExitMessageNumber, debugMessageNumber = 43, 1043
+			return result, wraperror.Errorf(err, "getRegisteredDataSources")
+		}
 
-		return wraperror.Errorf(err, "SetLogLevel: %s", logLevelName)
+		result = CompareDataSources(expectedDataSources, registeredDataSources, builtInDataSources)
 	}
 
-	senzingConfig.isTrace = (logLevelName == logging.LevelTraceName)
+	result.From = expectedFile
+	result.To = fmt.Sprintf("Senzing configuration %d (default)", configID)
+
+	if HasConfigDifferences(result) {
+		senzingConfig.log(3005, configID, expectedFile)
+	} else {
+		senzingConfig.log(2023, configID, expectedFile)
+	}
 
 	// Notify observers.
 
 	if senzingConfig.observers != nil {
 		go func() {
 			details := map[string]string{
-				"logLevelName": logLevelName,
+				"configID":       strconv.FormatInt(configID, 10),
+				"expectedFile":   expectedFile,
+				"hasDifferences": strconv.FormatBool(HasConfigDifferences(result)),
 			}
-			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8004, err, details)
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8019, err, details)
 		}()
 	}
 
-	return wraperror.Errorf(err, wraperror.NoMessage)
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
 
 /*
-The SetObserverOrigin method sets the "origin" value in future Observer messages.
+The DiffConfigs method lists the differences between two Senzing configurations.
+Each configuration is given by a configuration ID in the database, a file,
+or another repository's Senzing settings JSON or database URL, whose default configuration is used.
 
 Input
   - ctx: A context to control lifecycle.
-  - origin: The value sent in the Observer's "origin" key/value pair.
+  - configLocation1: The Senzing configuration compared from.
+  - configLocation2: The Senzing configuration compared to.
+
+Output
+  - The differences, grouped into sections.  See CompareConfigs.
 */
-func (senzingConfig *BasicSenzingConfig) SetObserverOrigin(ctx context.Context, origin string) {
-	var err error
+func (senzingConfig *BasicSenzingConfig) DiffConfigs(
+	ctx context.Context,
+	configLocation1 string,
+	configLocation2 string,
+) (ConfigDiff, error) {
+	var (
+		err    error
+		result ConfigDiff
+	)
 
 	// Prolog.
 
 	debugMessageNumber := 0
-	traceExitMessageNumber := 69
+	traceExitMessageNumber := 179
 
 	if senzingConfig.getLogger().IsDebug() {
 		// If DEBUG, log error exit.
@@ -396,56 +460,141 @@ func (senzingConfig *BasicSenzingConfig) SetObserverOrigin(ctx context.Context,
 
 		if senzingConfig.getLogger().IsTrace() {
 			entryTime := time.Now()
+			from, to := describeConfigLocation(configLocation1), describeConfigLocation(configLocation2)
 
-			senzingConfig.traceEntry(60, origin)
+			senzingConfig.traceEntry(170, from, to)
 
-			defer func() {
-				senzingConfig.traceExit(traceExitMessageNumber, origin, err, time.Since(entryTime))
-			}()
+			defer func() { senzingConfig.traceExit(traceExitMessageNumber, from, to, err, time.Since(entryTime)) }()
 		}
 
 		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
 
 		asJSON, err := json.Marshal(senzingConfig)
 		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 61, 1061
+			traceExitMessageNumber, debugMessageNumber = 171, 1171
 
-			return
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
 		}
 
-		senzingConfig.log(1004, senzingConfig, string(asJSON))
+		senzingConfig.log(1170, senzingConfig, string(asJSON))
 	}
 
-	// Notify observers.
+	// Configurations in files and other repositories are read first,
+	// because Senzing supports one set of settings per process at a time.
 
-	if senzingConfig.observers != nil {
-		go func() {
-			details := map[string]string{
-				"origin": origin,
-			}
-			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8005, err, details)
-		}()
-	}
-}
+	configLocations := []string{configLocation1, configLocation2}
+	configDefinitions := make([]string, len(configLocations))
+	configIDs := make([]int64, len(configLocations))
 
-/*
-The UnregisterObserver method removes the observer to the list of observers notified.
+	for index, configLocation := range configLocations {
+		configID, isConfigID := getConfigLocationID(configLocation)
+		if isConfigID {
+			configIDs[index] = configID
 
-Input
-  - ctx: A context to control lifecycle.
-  - observer: The observer to be removed.
-*/
-func (senzingConfig *BasicSenzingConfig) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
-	var err error
+			continue
+		}
 
-	if observer == nil {
-		return err
+		configDefinitions[index], err = senzingConfig.readConfigLocation(ctx, configLocation)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 172, 1172
+
+			return result, wraperror.Errorf(err, "readConfigLocation: %s", describeConfigLocation(configLocation))
+		}
 	}
 
+	// Configurations given by ID are read from the database.
+
+	if slices.ContainsFunc(configIDs, func(configID int64) bool { return configID > 0 }) {
+		var szAbstractFactory senzing.SzAbstractFactory
+
+		szAbstractFactory, err = senzingConfig.getAbstractFactory(ctx)
+		if err != nil {
+			return result, wraperror.Errorf(err, "getAbstractFactory")
+		}
+
+		defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+		var (
+			szConfig        senzing.SzConfig
+			szConfigManager senzing.SzConfigManager
+		)
+
+		szConfigManager, err = szAbstractFactory.CreateConfigManager(ctx)
+		if err != nil {
+			return result, wraperror.Errorf(err, "CreateConfigManager")
+		}
+
+		defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+		for index, configID := range configIDs {
+			if configID == 0 {
+				continue
+			}
+
+			szConfig, err = szConfigManager.CreateConfigFromConfigID(ctx, configID)
+			if err != nil {
+				traceExitMessageNumber, debugMessageNumber = 173, 1173
+
+				return result, wraperror.Errorf(err, "CreateConfigFromConfigID: %d", configID)
+			}
+
+			configDefinitions[index], err = szConfig.Export(ctx)
+			if err != nil {
+				traceExitMessageNumber, debugMessageNumber = 174, 1174
+
+				return result, wraperror.Errorf(err, "Export: %d", configID)
+			}
+		}
+	}
+
+	result, err = CompareConfigs(configDefinitions[0], configDefinitions[1])
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 175, 1175
+
+		return result, wraperror.Errorf(err, "CompareConfigs")
+	}
+
+	result.From = describeConfigLocation(configLocation1)
+	result.To = describeConfigLocation(configLocation2)
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"from": result.From,
+				"to":   result.To,
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8017, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The ExportConfig method returns the default Senzing configuration as JSON.
+
+Input
+  - ctx: A context to control lifecycle.
+  - stripVolatileFields: If true, remove fields that change without the configuration changing,
+    such as the build version of the Senzing engine that created it.
+    This lets exports from different environments be compared.
+
+Output
+  - The Senzing configuration JSON.
+*/
+func (senzingConfig *BasicSenzingConfig) ExportConfig(ctx context.Context, stripVolatileFields bool) (string, error) {
+	var (
+		err      error
+		configID int64
+		result   string
+	)
+
 	// Prolog.
 
 	debugMessageNumber := 0
-	traceExitMessageNumber := 59
+	traceExitMessageNumber := 129
 
 	if senzingConfig.getLogger().IsDebug() {
 		// If DEBUG, log error exit.
@@ -460,10 +609,10 @@ func (senzingConfig *BasicSenzingConfig) UnregisterObserver(ctx context.Context,
 		if senzingConfig.getLogger().IsTrace() {
 			entryTime := time.Now()
 
-			senzingConfig.traceEntry(50, observer.GetObserverID(ctx))
+			senzingConfig.traceEntry(120, stripVolatileFields)
 
 			defer func() {
-				senzingConfig.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+				senzingConfig.traceExit(traceExitMessageNumber, stripVolatileFields, configID, err, time.Since(entryTime))
 			}()
 		}
 
@@ -471,174 +620,163 @@ func (senzingConfig *BasicSenzingConfig) UnregisterObserver(ctx context.Context,
 
 		asJSON, err := json.Marshal(senzingConfig)
 		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 51, 1051
+			traceExitMessageNumber, debugMessageNumber = 121, 1121
 
-			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
 		}
 
-		senzingConfig.log(1005, senzingConfig, string(asJSON))
+		senzingConfig.log(1009, senzingConfig, string(asJSON))
 	}
 
-	// Remove observer from this service.
-
-	if senzingConfig.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
-		details := map[string]string{
-			"observerID": observer.GetObserverID(ctx),
-		}
-		notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8006, err, details)
+	// Create Senzing objects.
 
-		err = senzingConfig.observers.UnregisterObserver(ctx, observer)
-		if err != nil {
-			traceExitMessageNumber, debugMessageNumber = 54, 1054
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getAbstractFactory")
+	}
 
-			return wraperror.Errorf(err, "UnregisterObserver")
-		}
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
 
-		if !senzingConfig.observers.HasObservers(ctx) {
-			senzingConfig.observers = nil
-		}
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "CreateConfigManager")
 	}
 
-	return wraperror.Errorf(err, wraperror.NoMessage)
-}
-
-// ----------------------------------------------------------------------------
-// Private methods
-// ----------------------------------------------------------------------------
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
 
-// --- Logging ----------------------------------------------------------------
+	configID, err = szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 122, 1122
 
-// Get the Logger singleton.
-func (senzingConfig *BasicSenzingConfig) getLogger() logging.Logging {
-	var err error
+		return result, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
 
-	if senzingConfig.logger == nil {
-		options := []interface{}{
-			&logging.OptionCallerSkip{Value: OptionCallerSkip4},
-			logging.OptionMessageFields{Value: []string{"id", "text"}},
-		}
+	if configID == 0 {
+		traceExitMessageNumber, debugMessageNumber = 122, 1122
 
-		senzingConfig.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
-		if err != nil {
-			panic(err)
-		}
+		return result, wraperror.Errorf(errForPackage, "no default Senzing configuration to export")
 	}
 
-	return senzingConfig.logger
-}
-
-// Log message.
-func (senzingConfig *BasicSenzingConfig) log(messageNumber int, details ...interface{}) {
-	senzingConfig.getLogger().Log(messageNumber, details...)
-}
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 123, 1123
 
-// Debug.
-func (senzingConfig *BasicSenzingConfig) debug(messageNumber int, details ...interface{}) {
-	details = append(details, debugOptions...)
-	senzingConfig.getLogger().Log(messageNumber, details...)
-}
+		return result, wraperror.Errorf(err, "CreateConfigFromConfigID: %d", configID)
+	}
 
-// Trace method entry.
-func (senzingConfig *BasicSenzingConfig) traceEntry(messageNumber int, details ...interface{}) {
-	details = append(details, traceOptions...)
-	senzingConfig.getLogger().Log(messageNumber, details...)
-}
+	result, err = szConfig.Export(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 124, 1124
 
-// Trace method exit.
-func (senzingConfig *BasicSenzingConfig) traceExit(messageNumber int, details ...interface{}) {
-	details = append(details, traceOptions...)
-	senzingConfig.getLogger().Log(messageNumber, details...)
-}
+		return result, wraperror.Errorf(err, "Export")
+	}
 
-// --- Dependent services -----------------------------------------------------
+	if !stripVolatileFields {
+		var registry configRegistry
 
-// Create an abstract factory singleton and return it.
-func (senzingConfig *BasicSenzingConfig) getAbstractFactory(ctx context.Context) senzing.SzAbstractFactory {
-	var err error
+		registry, err = getConfigRegistry(ctx, szConfigManager)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 126, 1126
 
-	_ = ctx
+			return result, wraperror.Errorf(err, "getConfigRegistry")
+		}
 
-	senzingConfig.szAbstractFactorySyncOnce.Do(func() {
-		if len(senzingConfig.GrpcTarget) == 0 {
-			senzingConfig.szAbstractFactorySingleton, err = senzingConfig.buildSzAbstractFactory()
-			if err != nil {
-				panic(err)
-			}
-		} else {
-			grpcConnection, err := grpc.NewClient(senzingConfig.GrpcTarget, senzingConfig.GrpcDialOptions...)
-			if err != nil {
-				panic(err)
+		for _, config := range registry.Configs {
+			if config.ConfigID != configID {
+				continue
 			}
 
-			senzingConfig.szAbstractFactorySingleton, err = szfactorycreator.CreateGrpcAbstractFactory(grpcConnection)
+			result, err = addConfigMetadata(result, configID, config.ConfigComments)
 			if err != nil {
-				panic(err)
+				traceExitMessageNumber, debugMessageNumber = 127, 1127
+
+				return result, wraperror.Errorf(err, "addConfigMetadata")
 			}
 		}
-	})
-
-	return senzingConfig.szAbstractFactorySingleton
-}
+	}
 
-// --- Misc -------------------------------------------------------------------
+	if stripVolatileFields {
+		result, err = removeVolatileFields(result)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 125, 1125
 
-func (senzingConfig *BasicSenzingConfig) buildSzAbstractFactory() (senzing.SzAbstractFactory, error) {
-	var (
-		err    error
-		result senzing.SzAbstractFactory
-	)
+			return result, wraperror.Errorf(err, "removeVolatileFields")
+		}
+	}
 
-	senzingSettings := senzingConfig.SenzingSettings
+	// Notify observers.
 
-	senzingInstanceName := senzingConfig.SenzingInstanceName
-	if len(senzingInstanceName) == 0 {
-		senzingInstanceName = fmt.Sprintf("senzing init-database at %s", time.Now())
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"configID":            strconv.FormatInt(configID, 10),
+				"stripVolatileFields": strconv.FormatBool(stripVolatileFields),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8010, err, details)
+		}()
 	}
 
-	result, err = szfactorycreator.CreateCoreAbstractFactory(
-		senzingInstanceName,
-		senzingSettings,
-		senzingConfig.SenzingVerboseLogging,
-		senzing.SzInitializeWithDefaultConfiguration,
-	)
-
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
 
-func (senzingConfig *BasicSenzingConfig) makeDefaultConfig(
-	ctx context.Context,
-	szAbstractFactory senzing.SzAbstractFactory,
-	szConfig senzing.SzConfig,
-) (int64, error) {
-	var (
-		err    error
-		result int64
-	)
+/*
+The GetConfigHistory method lists every Senzing configuration stored in the database.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The stored configurations, oldest first.
+*/
+func (senzingConfig *BasicSenzingConfig) GetConfigHistory(ctx context.Context) ([]ConfigHistoryEntry, error) {
+	var err error
+
+	result := []ConfigHistoryEntry{}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 89
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(80)
+
+			defer func() { senzingConfig.traceExit(traceExitMessageNumber, len(result), err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
 
-	for _, datasource := range senzingConfig.DataSources {
-		_, err = szConfig.RegisterDataSource(ctx, datasource)
+		asJSON, err := json.Marshal(senzingConfig)
 		if err != nil {
-			return result, wraperror.Errorf(err, "RegisterDataSource: %s", datasource)
+			traceExitMessageNumber, debugMessageNumber = 81, 1081
+
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
 		}
 
-		senzingConfig.log(2001, datasource)
+		senzingConfig.log(1007, senzingConfig, string(asJSON))
 	}
 
-	configComment := fmt.Sprintf(
-		"Created by init-database at %s with datasources: %s ",
-		time.Now().Format(time.RFC3339),
-		strings.Join(senzingConfig.DataSources, " "),
-	)
+	// Create Senzing objects.
 
-	configDefinition, err := szConfig.Export(ctx)
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
 	if err != nil {
-		return result, wraperror.Errorf(err, "Export")
+		return result, wraperror.Errorf(err, "getAbstractFactory")
 	}
 
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
 	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
 	if err != nil {
 		return result, wraperror.Errorf(err, "CreateConfigManager")
@@ -646,17 +784,2178 @@ func (senzingConfig *BasicSenzingConfig) makeDefaultConfig(
 
 	defer func() { _ = szConfigManager.Destroy(ctx) }()
 
-	result, err = szConfigManager.SetDefaultConfig(ctx, configDefinition, configComment)
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 82, 1082
+
+		return result, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	registry, err := getConfigRegistry(ctx, szConfigManager)
 	if err != nil {
-		return result, wraperror.Errorf(err, "SetDefaultConfig")
+		traceExitMessageNumber, debugMessageNumber = 83, 1083
+
+		return result, wraperror.Errorf(err, "getConfigRegistry")
+	}
+
+	// Describe each configuration.
+
+	for _, config := range registry.Configs {
+		szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, config.ConfigID)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 84, 1084
+
+			return result, wraperror.Errorf(err, "CreateConfigFromConfigID: %d", config.ConfigID)
+		}
+
+		dataSources, err := getRegisteredDataSources(ctx, szConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 85, 1085
+
+			return result, wraperror.Errorf(err, "getRegisteredDataSources: %d", config.ConfigID)
+		}
+
+		comment, labels := splitConfigComment(config.ConfigComments)
+
+		result = append(result, ConfigHistoryEntry{
+			Comment:     comment,
+			ConfigID:    config.ConfigID,
+			CreatedOn:   config.SysCreateDt,
+			DataSources: dataSources,
+			IsDefault:   config.ConfigID == defaultConfigID,
+			Labels:      labels,
+		})
+	}
+
+	slices.SortStableFunc(result, func(a, b ConfigHistoryEntry) int {
+		return strings.Compare(a.CreatedOn, b.CreatedOn)
+	})
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"configCount": strconv.Itoa(len(result)),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8008, err, details)
+		}()
 	}
 
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
 
-// ----------------------------------------------------------------------------
-// Private functions
-// ----------------------------------------------------------------------------
+/*
+The InitializeSenzing method adds the Senzing default configuration to databases.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (senzingConfig *BasicSenzingConfig) InitializeSenzing(ctx context.Context) error {
+	var err error
+
+	var configID int64
+
+	entryTime := time.Now()
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 29
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			senzingConfig.traceEntry(10, configID)
+
+			defer func() { senzingConfig.traceExit(traceExitMessageNumber, configID, err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 11, 1011
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1001, senzingConfig, string(asJSON))
+	}
+
+	// If a configuration source is specified, read it before creating Senzing objects for this repository.
+
+	var sourceDefinition string
+
+	if len(senzingConfig.ConfigSource) > 0 {
+		sourceDefinition, err = senzingConfig.readConfigSource(ctx, senzingConfig.ConfigSource)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 150, 1150
+
+			return wraperror.Errorf(err, "readConfigSource")
+		}
+	}
+
+	// Create Senzing objects.
+
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	// If a configuration source is specified, use it.
+
+	if len(sourceDefinition) > 0 {
+		configID, err = senzingConfig.installConfigSource(ctx, szAbstractFactory, sourceDefinition)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 151, 1151
+
+			return wraperror.Errorf(err, "installConfigSource")
+		}
+
+		return nil
+	}
+
+	// If a Senzing configuration file is specified, use it.
+	// If the file is already the Senzing configuration template, an existing default is kept, as without a file.
+
+	var isTemplateConfigFile bool
+
+	if len(senzingConfig.SenzingConfigJSONFile) > 0 {
+		_, err = os.Stat(senzingConfig.SenzingConfigJSONFile)
+		if err != nil {
+			senzingConfig.log(5001, senzingConfig.SenzingConfigJSONFile)
+
+			traceExitMessageNumber, debugMessageNumber = 22, 1022
+
+			return wraperror.Errorf(err, "os.Stat: %s", senzingConfig.SenzingConfigJSONFile)
+		}
+
+		configDefinition, err1 := fileToString(ctx, senzingConfig.SenzingConfigJSONFile)
+		if err1 != nil {
+			traceExitMessageNumber, debugMessageNumber = 99, 1999
+
+			return wraperror.Errorf(err1, "fileToString: %s", senzingConfig.SenzingConfigJSONFile)
+		}
+
+		compatibilityVersion, err1 := getEngineCompatibilityVersion(ctx, szAbstractFactory)
+		if err1 != nil {
+			traceExitMessageNumber, debugMessageNumber = 27, 1027
+
+			return wraperror.Errorf(err1, "getEngineCompatibilityVersion")
+		}
+
+		err1 = ValidateConfigDefinition(configDefinition, compatibilityVersion)
+		if err1 != nil {
+			traceExitMessageNumber, debugMessageNumber = 28, 1028
+
+			return wraperror.Errorf(err1, "ValidateConfigDefinition: %s", senzingConfig.SenzingConfigJSONFile)
+		}
+
+		// Install the file as the Senzing configuration template, keeping a backup of the current template.
+
+		settingsParser, err1 := settingsparser.New(senzingConfig.SenzingSettings)
+		if err1 != nil {
+			traceExitMessageNumber, debugMessageNumber = 20, 1020
+
+			return wraperror.Errorf(err1, "settingsparser.New")
+		}
+
+		resourcePath, err1 := settingsParser.GetResourcePath(ctx)
+		if err1 != nil {
+			traceExitMessageNumber, debugMessageNumber = 21, 1021
+
+			return wraperror.Errorf(err1, "GetResourcePath")
+		}
+
+		templateFile := filepath.Join(resourcePath, engineConfigurationTemplate)
+
+		templateDefinition, err1 := fileToString(ctx, templateFile)
+		isTemplateConfigFile = err1 == nil && templateDefinition == configDefinition
+
+		if isTemplateConfigFile {
+			senzingConfig.log(2005, senzingConfig.SenzingConfigJSONFile, templateFile)
+		} else {
+			if err1 == nil {
+				backupFile := fmt.Sprintf("%s.%s.bak", templateFile, time.Now().Format("20060102150405"))
+
+				err1 = copyFile(templateFile, backupFile)
+				if err1 != nil {
+					senzingConfig.log(5002, templateFile, backupFile)
+
+					traceExitMessageNumber, debugMessageNumber = 23, 1023
+
+					return wraperror.Errorf(err1, "copyFile: %s to %s", templateFile, backupFile)
+				}
+
+				senzingConfig.log(2004, templateFile, backupFile)
+			}
+
+			err1 = copyFile(senzingConfig.SenzingConfigJSONFile, templateFile)
+			if err1 != nil {
+				senzingConfig.log(5003, senzingConfig.SenzingConfigJSONFile, templateFile)
+
+				traceExitMessageNumber, debugMessageNumber = 24, 1024
+
+				return wraperror.Errorf(err1, "copyFile: %s to %s", senzingConfig.SenzingConfigJSONFile, templateFile)
+			}
+
+			senzingConfig.log(2024, senzingConfig.SenzingConfigJSONFile, templateFile)
+
+			if senzingConfig.observers != nil {
+				go func() {
+					details := map[string]string{
+						"sourceFile": senzingConfig.SenzingConfigJSONFile,
+						"targetFile": templateFile,
+					}
+					notifier.Notify(
+						ctx,
+						senzingConfig.observers,
+						senzingConfig.observerOrigin,
+						ComponentID,
+						8015,
+						err1,
+						details,
+					)
+				}()
+			}
+
+			szConfig, err2 := szConfigManager.CreateConfigFromString(ctx, configDefinition)
+			if err2 != nil {
+				traceExitMessageNumber, debugMessageNumber = 99, 1999
+
+				return wraperror.Errorf(err2, "CreateConfigFromString: %s", configDefinition)
+			}
+
+			// If the file, with datasources registered, matches the default configuration, keep the default.
+
+			var isChanged bool
+
+			configID, isChanged, err = senzingConfig.makeDefaultConfig(
+				ctx,
+				szAbstractFactory,
+				szConfig,
+				senzingConfig.DataSources,
+			)
+			if err != nil {
+				traceExitMessageNumber, debugMessageNumber = 99, 999
+
+				return wraperror.Errorf(err, "makeDefaultConfig")
+			}
+
+			if !isChanged {
+				senzingConfig.log(2007, configID, strings.Join(senzingConfig.DataSources, " "))
+
+				traceExitMessageNumber, debugMessageNumber = 14, 0 // debugMessageNumber=0 because it's not an error.
+
+				return nil
+			}
+
+			senzingConfig.log(2006, configID)
+
+			return nil
+		}
+	}
+
+	// Determine if configuration already exists. If so, return.
+
+	configID, err = szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 13, 1013
+
+		return wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if configID != 0 {
+		if senzingConfig.observers != nil {
+			go func() {
+				details := map[string]string{}
+				notifier.Notify(
+					ctx,
+					senzingConfig.observers,
+					senzingConfig.observerOrigin,
+					ComponentID,
+					8001,
+					err,
+					details,
+				)
+			}()
+		}
+
+		if len(senzingConfig.DataSources) > 0 {
+			// Only register datasources that are not already in the default configuration.
+
+			var isChanged bool
+
+			configID, isChanged, err = senzingConfig.updateDefaultConfig(
+				ctx,
+				szAbstractFactory,
+				func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error) {
+					missingDataSources, err := getMissingDataSources(ctx, szConfig, senzingConfig.DataSources)
+					if err != nil || len(missingDataSources) == 0 {
+						return "", "", wraperror.Errorf(err, "getMissingDataSources")
+					}
+
+					return senzingConfig.registerDataSources(ctx, szConfig, missingDataSources)
+				},
+			)
+			if err != nil {
+				traceExitMessageNumber, debugMessageNumber = 26, 1026
+
+				return wraperror.Errorf(err, "updateDefaultConfig")
+			}
+
+			if !isChanged {
+				senzingConfig.log(2007, configID, strings.Join(senzingConfig.DataSources, " "))
+
+				traceExitMessageNumber, debugMessageNumber = 14, 0 // debugMessageNumber=0 because it's not an error.
+
+				return nil
+			}
+		}
+
+		senzingConfig.log(2002, configID)
+
+		traceExitMessageNumber, debugMessageNumber = 14, 0 // debugMessageNumber=0 because it's not an error.
+
+		return wraperror.Errorf(err, "ConfigID: %d", configID)
+	}
+
+	// If no configuration file specified, or the file is the template, install the template.
+
+	if len(senzingConfig.SenzingConfigJSONFile) == 0 || isTemplateConfigFile {
+		szConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 99, 1999
+
+			return wraperror.Errorf(err, "CreateConfigFromTemplate")
+		}
+
+		configID, _, err = senzingConfig.makeDefaultConfig(ctx, szAbstractFactory, szConfig, senzingConfig.DataSources)
+		senzingConfig.log(2006, configID)
+
+		traceExitMessageNumber, debugMessageNumber = 999, 999
+
+		return wraperror.Errorf(err, "makeDefaultConfig")
+	}
+
+	// Notify observers.
+
+	senzingConfig.log(2003, configID)
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8002, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The PruneConfigs method removes old Senzing configurations from the database.
+The default configuration, the keep most recent configurations, and pinned configurations are kept.
+See PlanConfigPrune.
+
+The default configuration is read again just before removal and is never removed,
+even if another process changed it in the meantime.
+
+Input
+  - ctx: A context to control lifecycle.
+  - keep: The number of most recent configurations to keep.
+  - pinnedConfigIDs: The IDs of configurations to keep.
+  - dryRun: If true, only list what would be removed.
+
+Output
+  - The configurations kept and removed.
+*/
+func (senzingConfig *BasicSenzingConfig) PruneConfigs(
+	ctx context.Context,
+	keep int,
+	pinnedConfigIDs []int64,
+	dryRun bool,
+) (ConfigPrunePlan, error) {
+	var (
+		err    error
+		result ConfigPrunePlan
+	)
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 189
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(180, keep, pinnedConfigIDs, dryRun)
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, keep, pinnedConfigIDs, dryRun, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 181, 1181
+
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1180, senzingConfig, string(asJSON))
+	}
+
+	if keep < 0 {
+		traceExitMessageNumber, debugMessageNumber = 182, 1182
+		err = wraperror.Errorf(errForPackage, "number of Senzing configurations to keep must not be negative: %d", keep)
+
+		return result, err
+	}
+
+	// Create Senzing objects.
+
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	// Decide what to remove.
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 183, 1183
+
+		return result, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	registry, err := getConfigRegistry(ctx, szConfigManager)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 184, 1184
+
+		return result, wraperror.Errorf(err, "getConfigRegistry")
+	}
+
+	configHistory := []ConfigHistoryEntry{}
+
+	for _, config := range registry.Configs {
+		comment, labels := splitConfigComment(config.ConfigComments)
+		configHistory = append(configHistory, ConfigHistoryEntry{
+			Comment:   comment,
+			ConfigID:  config.ConfigID,
+			CreatedOn: config.SysCreateDt,
+			IsDefault: config.ConfigID == defaultConfigID,
+			Labels:    labels,
+		})
+	}
+
+	result = PlanConfigPrune(configHistory, keep, pinnedConfigIDs)
+	result.IsDryRun = dryRun
+
+	if dryRun {
+		for _, configPruneEntry := range result.Removed {
+			senzingConfig.log(2021, configPruneEntry.ConfigID, configPruneEntry.CreatedOn)
+		}
+
+		return result, nil
+	}
+
+	if len(result.Removed) == 0 {
+		senzingConfig.log(2022, 0, len(result.Kept))
+
+		return result, nil
+	}
+
+	// deleteConfigs keeps the default configuration, which may have changed since it was read.
+
+	removedConfigIDs := []int64{}
+	for _, configPruneEntry := range result.Removed {
+		removedConfigIDs = append(removedConfigIDs, configPruneEntry.ConfigID)
+	}
+
+	// Remove.
+
+	err = senzingConfig.deleteConfigs(ctx, removedConfigIDs)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 186, 1186
+
+		return result, wraperror.Errorf(err, "deleteConfigs")
+	}
+
+	for _, configPruneEntry := range result.Removed {
+		senzingConfig.log(2020, configPruneEntry.ConfigID, configPruneEntry.CreatedOn)
+	}
+
+	senzingConfig.log(2022, len(result.Removed), len(result.Kept))
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"keep":    strconv.Itoa(keep),
+				"kept":    strconv.Itoa(len(result.Kept)),
+				"removed": strconv.Itoa(len(result.Removed)),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8018, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The RegisterObserver method adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (senzingConfig *BasicSenzingConfig) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	if observer == nil {
+		return err
+	}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 39
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(30, observer.GetObserverID(ctx))
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 31, 1031
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1002, senzingConfig, string(asJSON))
+	}
+
+	// Create empty list of observers.
+
+	if senzingConfig.observers == nil {
+		senzingConfig.observers = &subject.SimpleSubject{}
+	}
+
+	// Register observer with senzingConfig.
+
+	err = senzingConfig.observers.RegisterObserver(ctx, observer)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 32, 1032
+
+		return wraperror.Errorf(err, "RegisterObserver")
+	}
+
+	// Notify observers.
+
+	go func() {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8003, err, details)
+	}()
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The RenameDataSource method replaces a datasource code in the default Senzing configuration.
+The new code is registered and the old code is unregistered.
+Records are not moved, so the old code may only be unregistered if it has no records, unless force is true.
+
+Input
+  - ctx: A context to control lifecycle.
+  - oldDataSource: The datasource code to replace.
+  - newDataSource: The new datasource code.
+  - force: If true, unregister the old code even if it still has records.
+*/
+func (senzingConfig *BasicSenzingConfig) RenameDataSource(
+	ctx context.Context,
+	oldDataSource string,
+	newDataSource string,
+	force bool,
+) error {
+	var (
+		err      error
+		configID int64
+	)
+
+	oldDataSource = normalizeDataSource(oldDataSource)
+	newDataSource = normalizeDataSource(newDataSource)
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 149
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, oldDataSource, newDataSource, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(140, oldDataSource, newDataSource, force)
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, oldDataSource, newDataSource, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 141, 1141
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1140, senzingConfig, string(asJSON))
+	}
+
+	if oldDataSource == newDataSource {
+		traceExitMessageNumber, debugMessageNumber = 142, 1142
+
+		return wraperror.Errorf(errForPackage, "cannot rename datasource %s to itself", oldDataSource)
+	}
+
+	// Create Senzing objects.
+
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	configID, _, err = senzingConfig.updateDefaultConfig(
+		ctx,
+		szAbstractFactory,
+		func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error) {
+			return senzingConfig.renameDataSource(ctx, szConfig, oldDataSource, newDataSource, force)
+		},
+	)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 143, 1143
+
+		return wraperror.Errorf(err, "updateDefaultConfig")
+	}
+
+	senzingConfig.log(2014, oldDataSource, newDataSource, configID)
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"configID":      strconv.FormatInt(configID, 10),
+				"newDataSource": newDataSource,
+				"oldDataSource": oldDataSource,
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8012, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The RollbackConfig method makes an earlier Senzing configuration the default.
+If another process changes the default configuration at the same time,
+the rollback fails rather than overwriting that change.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The identifier of a stored Senzing configuration.
+*/
+func (senzingConfig *BasicSenzingConfig) RollbackConfig(ctx context.Context, configID int64) error {
+	var err error
+
+	var currentConfigID int64
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 119
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, configID, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(110, configID)
+
+			defer func() { senzingConfig.traceExit(traceExitMessageNumber, configID, err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 111, 1111
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1008, senzingConfig, string(asJSON))
+	}
+
+	// Create Senzing objects.
+
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	// Verify the requested configuration exists.
+
+	registry, err := getConfigRegistry(ctx, szConfigManager)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 112, 1112
+
+		return wraperror.Errorf(err, "getConfigRegistry")
+	}
+
+	if !slices.ContainsFunc(registry.Configs, func(config configRegistryEntry) bool {
+		return config.ConfigID == configID
+	}) {
+		traceExitMessageNumber, debugMessageNumber = 113, 1113
+
+		return wraperror.Errorf(errForPackage, "Senzing configuration %d does not exist", configID)
+	}
+
+	currentConfigID, err = szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 114, 1114
+
+		return wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if currentConfigID == configID {
+		senzingConfig.log(2012, configID)
+
+		return nil
+	}
+
+	// ReplaceDefaultConfigID fails if the default changed since it was read.
+
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, currentConfigID, configID)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 115, 1115
+
+		return wraperror.Errorf(err, "ReplaceDefaultConfigID: %d to %d", currentConfigID, configID)
+	}
+
+	senzingConfig.log(2011, currentConfigID, configID)
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"configID":         strconv.FormatInt(configID, 10),
+				"previousConfigID": strconv.FormatInt(currentConfigID, 10),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8009, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The SetLogLevel method sets the level of logging.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
+*/
+func (senzingConfig *BasicSenzingConfig) SetLogLevel(ctx context.Context, logLevelName string) error {
+	var err error
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 49
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(40, logLevelName)
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, logLevelName, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 41, 1041
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1003, senzingConfig, string(asJSON))
+	}
+
+	// Verify value of logLevelName.
+
+	if !logging.IsValidLogLevelName(logLevelName) {
+		traceExitMessageNumber, debugMessageNumber = 42, 1042
+
+		return wraperror.Errorf(errForPackage, "invalid error level: %s", logLevelName)
+	}
+
+	// Set senzingConfig log level.
+
+	senzingConfig.logLevel = logLevelName
+
+	err = senzingConfig.getLogger().SetLogLevel(logLevelName)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 43, 1043
+
+		return wraperror.Errorf(err, "SetLogLevel: %s", logLevelName)
+	}
+
+	senzingConfig.isTrace = (logLevelName == logging.LevelTraceName)
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"logLevelName": logLevelName,
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8004, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The SetObserverOrigin method sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (senzingConfig *BasicSenzingConfig) SetObserverOrigin(ctx context.Context, origin string) {
+	var err error
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 69
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(60, origin)
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, origin, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 61, 1061
+
+			return
+		}
+
+		senzingConfig.log(1004, senzingConfig, string(asJSON))
+	}
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"origin": origin,
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8005, err, details)
+		}()
+	}
+}
+
+/*
+The UnregisterDataSources method removes datasources from the default Senzing configuration.
+A datasource that still has records is not removed, unless force is true.
+Datasources that are not registered are ignored.
+All removals are saved as a single new default Senzing configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSources: The datasource codes to remove.
+  - force: If true, remove datasources even if they still have records.
+*/
+func (senzingConfig *BasicSenzingConfig) UnregisterDataSources(
+	ctx context.Context,
+	dataSources []string,
+	force bool,
+) error {
+	var (
+		err      error
+		configID int64
+	)
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 139
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, dataSources, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(130, dataSources, force)
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, dataSources, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 131, 1131
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1130, senzingConfig, string(asJSON))
+	}
+
+	// Create Senzing objects.
+
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "getAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	var (
+		isChanged          bool
+		removedDataSources []string
+	)
+
+	configID, isChanged, err = senzingConfig.updateDefaultConfig(
+		ctx,
+		szAbstractFactory,
+		func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error) {
+			var (
+				configComment    string
+				configDefinition string
+				err              error
+			)
+
+			configDefinition, configComment, removedDataSources, err = senzingConfig.unregisterDataSources(
+				ctx,
+				szConfig,
+				dataSources,
+				force,
+			)
+
+			return configDefinition, configComment, err
+		},
+	)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 132, 1132
+
+		return wraperror.Errorf(err, "updateDefaultConfig")
+	}
+
+	if !isChanged {
+		return nil
+	}
+
+	for _, dataSource := range removedDataSources {
+		senzingConfig.log(2013, dataSource)
+	}
+
+	senzingConfig.log(2006, configID)
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"configID":    strconv.FormatInt(configID, 10),
+				"dataSources": strings.Join(removedDataSources, " "),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8011, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The UnregisterObserver method removes the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (senzingConfig *BasicSenzingConfig) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	if observer == nil {
+		return err
+	}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 59
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(50, observer.GetObserverID(ctx))
+
+			defer func() {
+				senzingConfig.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 51, 1051
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1005, senzingConfig, string(asJSON))
+	}
+
+	// Remove observer from this service.
+
+	if senzingConfig.observers != nil {
+		// Tricky code:
+		// client.notify is called synchronously before client.observers is set to nil.
+		// In client.notify, each observer will get notified in a goroutine.
+		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8006, err, details)
+
+		err = senzingConfig.observers.UnregisterObserver(ctx, observer)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 54, 1054
+
+			return wraperror.Errorf(err, "UnregisterObserver")
+		}
+
+		if !senzingConfig.observers.HasObservers(ctx) {
+			senzingConfig.observers = nil
+		}
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The UpgradeConfig method merges the current Senzing configuration template into the default Senzing configuration.
+
+A three-way merge is done of the template the default configuration was based on,
+the default configuration, and the template from SzConfigManager.CreateConfigFromTemplate.
+Customizations of the default configuration are kept; on conflict, the current value is kept.
+The merged configuration becomes the default configuration only if confirm returns true.
+
+Input
+  - ctx: A context to control lifecycle.
+  - baseConfigDefinition: The Senzing configuration template the default configuration was based on.
+  - confirm: Given the plan, decides whether to make the merged configuration the default.
+
+Output
+  - The plan.  If a new default configuration was made, NewConfigID is set.
+*/
+func (senzingConfig *BasicSenzingConfig) UpgradeConfig(
+	ctx context.Context,
+	baseConfigDefinition string,
+	confirm func(configUpgradePlan ConfigUpgradePlan) bool,
+) (ConfigUpgradePlan, error) {
+	var err error
+
+	result := ConfigUpgradePlan{
+		Changes:         []string{},
+		Conflicts:       []ConfigMergeConflict{},
+		CurrentConfigID: 0,
+		NewConfigID:     0,
+	}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 169
+
+	if senzingConfig.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingConfig.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingConfig.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingConfig.traceEntry(160)
+
+			defer func() { senzingConfig.traceExit(traceExitMessageNumber, err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingConfig)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 161, 1161
+
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingConfig)
+		}
+
+		senzingConfig.log(1160, senzingConfig, string(asJSON))
+	}
+
+	// Create Senzing objects.
+
+	szAbstractFactory, err := senzingConfig.getAbstractFactory(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	// Merge the current template into the default configuration.
+
+	result.CurrentConfigID, err = szConfigManager.GetDefaultConfigID(ctx)
+	if err == nil && result.CurrentConfigID == 0 {
+		err = wraperror.Errorf(errForPackage, "no default Senzing configuration")
+	}
+
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 162, 1162
+
+		return result, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	currentSzConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, result.CurrentConfigID)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 163, 1163
+
+		return result, wraperror.Errorf(err, "CreateConfigFromConfigID: %d", result.CurrentConfigID)
+	}
+
+	currentConfigDefinition, err := currentSzConfig.Export(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 163, 1163
+
+		return result, wraperror.Errorf(err, "Export")
+	}
+
+	newSzConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 164, 1164
+
+		return result, wraperror.Errorf(err, "CreateConfigFromTemplate")
+	}
+
+	newConfigDefinition, err := newSzConfig.Export(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 164, 1164
+
+		return result, wraperror.Errorf(err, "Export")
+	}
+
+	mergedConfigDefinition, changes, conflicts, err := MergeConfigs(
+		baseConfigDefinition,
+		currentConfigDefinition,
+		newConfigDefinition,
+	)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 165, 1165
+
+		return result, wraperror.Errorf(err, "MergeConfigs")
+	}
+
+	result.Changes, result.Conflicts = changes, conflicts
+
+	for _, conflict := range conflicts {
+		senzingConfig.log(3004, conflict.Section, conflict.Key, conflict.Field)
+	}
+
+	if len(changes) == 0 {
+		senzingConfig.log(2017, result.CurrentConfigID)
+
+		return result, nil
+	}
+
+	if !confirm(result) {
+		senzingConfig.log(2019, result.CurrentConfigID)
+
+		return result, nil
+	}
+
+	// Save the merged configuration.  ReplaceDefaultConfigID fails if the default changed since it was read.
+
+	configComment := senzingConfig.formatConfigComment(
+		fmt.Sprintf("Upgraded from Senzing configuration %d", result.CurrentConfigID),
+		nil,
+		mergedConfigDefinition,
+	)
+
+	newConfigID, err := szConfigManager.RegisterConfig(
+		ctx,
+		mergedConfigDefinition,
+		addConfigLabels(configComment, senzingConfig.ConfigLabels),
+	)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 166, 1166
+
+		return result, wraperror.Errorf(err, "RegisterConfig")
+	}
+
+	// The merge was confirmed for the default that was read, so a conflict is not retried.
+
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, result.CurrentConfigID, newConfigID)
+	if errors.Is(err, szerror.ErrSzReplaceConflict) {
+		senzingConfig.reportReplaceConflict(ctx, err, result.CurrentConfigID, newConfigID, 1, 1)
+
+		traceExitMessageNumber, debugMessageNumber = 167, 1167
+
+		return result, wraperror.Errorf(
+			err,
+			"default Senzing configuration %d was changed by another process; run the upgrade again. "+
+				"Senzing configuration %d is not used and can be removed by config prune",
+			result.CurrentConfigID,
+			newConfigID,
+		)
+	}
+
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 167, 1167
+
+		return result, wraperror.Errorf(err, "ReplaceDefaultConfigID: %d to %d", result.CurrentConfigID, newConfigID)
+	}
+
+	result.NewConfigID = newConfigID
+	senzingConfig.log(2018, result.CurrentConfigID, result.NewConfigID, len(result.Changes))
+
+	// Notify observers.
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"changes":          strconv.Itoa(len(result.Changes)),
+				"configID":         strconv.FormatInt(result.NewConfigID, 10),
+				"conflicts":        strconv.Itoa(len(result.Conflicts)),
+				"previousConfigID": strconv.FormatInt(result.CurrentConfigID, 10),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8016, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// --- Logging ----------------------------------------------------------------
+
+// Get the Logger singleton.
+func (senzingConfig *BasicSenzingConfig) getLogger() logging.Logging {
+	var err error
+
+	if senzingConfig.logger == nil {
+		options := []interface{}{
+			&logging.OptionCallerSkip{Value: OptionCallerSkip4},
+			logging.OptionMessageFields{Value: []string{"id", "text"}},
+		}
+
+		senzingConfig.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return senzingConfig.logger
+}
+
+// Log message.
+func (senzingConfig *BasicSenzingConfig) log(messageNumber int, details ...interface{}) {
+	senzingConfig.getLogger().Log(messageNumber, details...)
+}
+
+// Debug.
+func (senzingConfig *BasicSenzingConfig) debug(messageNumber int, details ...interface{}) {
+	details = append(details, debugOptions...)
+	senzingConfig.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method entry.
+func (senzingConfig *BasicSenzingConfig) traceEntry(messageNumber int, details ...interface{}) {
+	details = append(details, traceOptions...)
+	senzingConfig.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method exit.
+func (senzingConfig *BasicSenzingConfig) traceExit(messageNumber int, details ...interface{}) {
+	details = append(details, traceOptions...)
+	senzingConfig.getLogger().Log(messageNumber, details...)
+}
+
+// --- Dependent services -----------------------------------------------------
+
+// Create an abstract factory.  The caller closes it.
+// Once closed, an abstract factory cannot create Senzing objects, so each method creates its own.
+// An abstract factory set by tests is wrapped so that closing it has no effect.
+func (senzingConfig *BasicSenzingConfig) getAbstractFactory(ctx context.Context) (senzing.SzAbstractFactory, error) {
+	_ = ctx
+
+	if senzingConfig.szAbstractFactory != nil {
+		return &callerOwnedAbstractFactory{SzAbstractFactory: senzingConfig.szAbstractFactory}, nil
+	}
+
+	if len(senzingConfig.GrpcTarget) == 0 {
+		return senzingConfig.buildSzAbstractFactory()
+	}
+
+	grpcConnection, err := grpc.NewClient(senzingConfig.GrpcTarget, senzingConfig.GrpcDialOptions...)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "grpc.NewClient: %s", senzingConfig.GrpcTarget)
+	}
+
+	result, err := szfactorycreator.CreateGrpcAbstractFactory(grpcConnection)
+
+	return result, wraperror.Errorf(err, "CreateGrpcAbstractFactory")
+}
+
+// Leave the wrapped abstract factory open.
+func (factory *callerOwnedAbstractFactory) Close(ctx context.Context) error {
+	_ = ctx
+
+	return nil
+}
+
+// --- Misc -------------------------------------------------------------------
+
+func (senzingConfig *BasicSenzingConfig) buildSzAbstractFactory() (senzing.SzAbstractFactory, error) {
+	var (
+		err    error
+		result senzing.SzAbstractFactory
+	)
+
+	senzingSettings := senzingConfig.SenzingSettings
+
+	senzingInstanceName := senzingConfig.SenzingInstanceName
+	if len(senzingInstanceName) == 0 {
+		senzingInstanceName = fmt.Sprintf("senzing init-database at %s", time.Now())
+	}
+
+	result, err = szfactorycreator.CreateCoreAbstractFactory(
+		senzingInstanceName,
+		senzingSettings,
+		senzingConfig.SenzingVerboseLogging,
+		senzing.SzInitializeWithDefaultConfiguration,
+	)
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// Register datasources in a Senzing configuration and make it the default.
+// Datasources registered in the current default configuration are registered too, so none are dropped.
+// If another process changes the default meanwhile, the datasources are registered in that default instead,
+// so its change is not overwritten.
+// Returns the default configuration ID and whether a new configuration was made the default;
+// if the result matches the current default, the current default is kept.
+func (senzingConfig *BasicSenzingConfig) makeDefaultConfig(
+	ctx context.Context,
+	szAbstractFactory senzing.SzAbstractFactory,
+	szConfig senzing.SzConfig,
+	dataSources []string,
+) (int64, bool, error) {
+	var (
+		configComment    string
+		configDefinition string
+		isAttempted      bool
+	)
+
+	// Register datasources in szConfig once; a retry with no default reuses the result.
+
+	buildConfig := func(ctx context.Context, currentDataSources []string) (string, string, error) {
+		if !isAttempted {
+			isAttempted = true
+
+			missingDataSources, err := getMissingDataSources(ctx, szConfig, MergeDataSources(currentDataSources, dataSources))
+			if err != nil {
+				return "", "", wraperror.Errorf(err, "getMissingDataSources")
+			}
+
+			configDefinition, configComment, err = senzingConfig.registerDataSources(ctx, szConfig, missingDataSources)
+			if err != nil {
+				return "", "", wraperror.Errorf(err, "registerDataSources")
+			}
+		}
+
+		return configDefinition, configComment, nil
+	}
+
+	result, isChanged, err := senzingConfig.replaceDefaultConfig(
+		ctx,
+		szAbstractFactory,
+		func(ctx context.Context) (string, string, error) {
+			return buildConfig(ctx, nil)
+		},
+		func(ctx context.Context, currentSzConfig senzing.SzConfig) (string, string, error) {
+			if isAttempted {
+				// The default configuration was changed by another process.
+
+				missingDataSources, err := getMissingDataSources(ctx, currentSzConfig, dataSources)
+				if err != nil || len(missingDataSources) == 0 {
+					return "", "", wraperror.Errorf(err, "getMissingDataSources")
+				}
+
+				return senzingConfig.registerDataSources(ctx, currentSzConfig, missingDataSources)
+			}
+
+			currentDataSources, err := getRegisteredDataSources(ctx, currentSzConfig)
+			if err != nil {
+				return "", "", wraperror.Errorf(err, "getRegisteredDataSources")
+			}
+
+			newDefinition, newComment, err := buildConfig(ctx, currentDataSources)
+			if err != nil {
+				return "", "", wraperror.Errorf(err, "buildConfig")
+			}
+
+			currentDefinition, err := currentSzConfig.Export(ctx)
+			if err != nil {
+				return "", "", wraperror.Errorf(err, "Export")
+			}
+
+			isSame, err := isSameConfigDefinition(newDefinition, currentDefinition)
+			if err != nil || isSame {
+				return "", "", wraperror.Errorf(err, "isSameConfigDefinition")
+			}
+
+			return newDefinition, newComment, nil
+		},
+	)
+
+	return result, isChanged, wraperror.Errorf(err, "replaceDefaultConfig")
+}
+
+// Register datasources and return the resulting Senzing configuration and a comment describing it.
+func (senzingConfig *BasicSenzingConfig) registerDataSources(
+	ctx context.Context,
+	szConfig senzing.SzConfig,
+	dataSources []string,
+) (string, string, error) {
+	for _, datasource := range dataSources {
+		_, err := szConfig.RegisterDataSource(ctx, datasource)
+		if err != nil {
+			return "", "", wraperror.Errorf(err, "RegisterDataSource: %s", datasource)
+		}
+
+		senzingConfig.log(2001, datasource)
+	}
+
+	configDefinition, err := szConfig.Export(ctx)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "Export")
+	}
+
+	configComment := senzingConfig.formatConfigComment("Created", dataSources, configDefinition)
+
+	return configDefinition, configComment, nil
+}
+
+/*
+Modify the default Senzing configuration and make the result the new default.
+
+See replaceDefaultConfig. It is an error if there is no default configuration.
+*/
+func (senzingConfig *BasicSenzingConfig) updateDefaultConfig(
+	ctx context.Context,
+	szAbstractFactory senzing.SzAbstractFactory,
+	modifyConfig func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error),
+) (int64, bool, error) {
+	result, isChanged, err := senzingConfig.replaceDefaultConfig(ctx, szAbstractFactory, nil, modifyConfig)
+
+	return result, isChanged, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Make a new Senzing configuration the default.
+
+The default configuration ID is read, modifyConfig is applied to that configuration,
+and the result replaces the default only if the default has not changed in the meantime.
+If there is no default configuration, createConfig makes the configuration instead,
+and it is made the default only if there is still no default.
+If another process changed the default, the whole read-modify-write is retried,
+up to MaxConfigUpdateAttempts times.
+
+Each failed attempt leaves its registered configuration behind in the configuration registry;
+its ID is logged and sent to observers as orphanConfigID.
+
+If modifyConfig returns an empty configuration definition, nothing is changed.
+Returns the default configuration ID and whether a new configuration was made the default.
+*/
+func (senzingConfig *BasicSenzingConfig) replaceDefaultConfig(
+	ctx context.Context,
+	szAbstractFactory senzing.SzAbstractFactory,
+	createConfig func(ctx context.Context) (string, string, error),
+	modifyConfig func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error),
+) (int64, bool, error) {
+	var result int64
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return result, false, wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	for attempt := 1; ; attempt++ {
+		currentConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+		if err != nil {
+			return result, false, wraperror.Errorf(err, "GetDefaultConfigID")
+		}
+
+		configDefinition, configComment, err := buildDefaultConfig(
+			ctx,
+			szConfigManager,
+			currentConfigID,
+			createConfig,
+			modifyConfig,
+		)
+		if err != nil {
+			return result, false, wraperror.Errorf(err, "buildDefaultConfig")
+		}
+
+		if len(configDefinition) == 0 {
+			return currentConfigID, false, nil
+		}
+
+		// Senzing rejects an invalid configuration here, before the default is changed.
+
+		newConfigID, err := szConfigManager.RegisterConfig(
+			ctx,
+			configDefinition,
+			addConfigLabels(configComment, senzingConfig.ConfigLabels),
+		)
+		if err != nil {
+			return result, false, wraperror.Errorf(err, "RegisterConfig")
+		}
+
+		err = replaceDefaultConfigID(ctx, szConfigManager, currentConfigID, newConfigID)
+		if err == nil {
+			return newConfigID, true, nil
+		}
+
+		if !errors.Is(err, szerror.ErrSzReplaceConflict) {
+			return result, false, wraperror.Errorf(err, "replaceDefaultConfigID: %d to %d", currentConfigID, newConfigID)
+		}
+
+		senzingConfig.reportReplaceConflict(ctx, err, currentConfigID, newConfigID, attempt, MaxConfigUpdateAttempts)
+
+		if attempt >= MaxConfigUpdateAttempts {
+			return result, false, wraperror.Errorf(
+				err,
+				"default Senzing configuration was changed by another process %d times",
+				attempt,
+			)
+		}
+
+		time.Sleep(time.Duration(attempt) * configUpdateRetryDelay)
+	}
+}
+
+// Log and notify observers that the default configuration was changed by another process,
+// leaving newConfigID registered but not the default.
+func (senzingConfig *BasicSenzingConfig) reportReplaceConflict(
+	ctx context.Context,
+	err error,
+	currentConfigID int64,
+	newConfigID int64,
+	attempt int,
+	maxAttempts int,
+) {
+	senzingConfig.log(3003, currentConfigID, attempt, maxAttempts, newConfigID)
+
+	if senzingConfig.observers != nil {
+		go func() {
+			details := map[string]string{
+				"attempt":        strconv.Itoa(attempt),
+				"configID":       strconv.FormatInt(currentConfigID, 10),
+				"isRetrying":     strconv.FormatBool(attempt < maxAttempts),
+				"maxAttempts":    strconv.Itoa(maxAttempts),
+				"orphanConfigID": strconv.FormatInt(newConfigID, 10),
+			}
+			notifier.Notify(ctx, senzingConfig.observers, senzingConfig.observerOrigin, ComponentID, 8013, err, details)
+		}()
+	}
+}
+
+// Replace a datasource code in a Senzing configuration.
+func (senzingConfig *BasicSenzingConfig) renameDataSource(
+	ctx context.Context,
+	szConfig senzing.SzConfig,
+	oldDataSource string,
+	newDataSource string,
+	force bool,
+) (string, string, error) {
+	registry, err := getDataSourceRegistry(ctx, szConfig)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "getDataSourceRegistry")
+	}
+
+	dataSourceIDs := getDataSourceIDs(registry)
+
+	oldDataSourceID, isOK := dataSourceIDs[oldDataSource]
+	if !isOK {
+		return "", "", wraperror.Errorf(errForPackage, "datasource %s is not registered", oldDataSource)
+	}
+
+	if _, isOK := dataSourceIDs[newDataSource]; isOK {
+		return "", "", wraperror.Errorf(errForPackage, "datasource %s is already registered", newDataSource)
+	}
+
+	err = senzingConfig.verifyNoRecords(ctx, oldDataSource, oldDataSourceID, force)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "verifyNoRecords")
+	}
+
+	_, err = szConfig.RegisterDataSource(ctx, newDataSource)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "RegisterDataSource: %s", newDataSource)
+	}
+
+	_, err = szConfig.UnregisterDataSource(ctx, oldDataSource)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "UnregisterDataSource: %s", oldDataSource)
+	}
+
+	configDefinition, err := szConfig.Export(ctx)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "Export")
+	}
+
+	configComment := senzingConfig.formatConfigComment(
+		fmt.Sprintf("Renamed datasource %s to %s", oldDataSource, newDataSource),
+		nil,
+		configDefinition,
+	)
+
+	return configDefinition, configComment, nil
+}
+
+// Remove datasources from a Senzing configuration.
+// Returns an empty configuration if none of the datasources are registered.
+func (senzingConfig *BasicSenzingConfig) unregisterDataSources(
+	ctx context.Context,
+	szConfig senzing.SzConfig,
+	dataSources []string,
+	force bool,
+) (string, string, []string, error) {
+	removedDataSources := []string{}
+
+	registry, err := getDataSourceRegistry(ctx, szConfig)
+	if err != nil {
+		return "", "", removedDataSources, wraperror.Errorf(err, "getDataSourceRegistry")
+	}
+
+	dataSourceIDs := getDataSourceIDs(registry)
+
+	// Determine which datasources can be removed.
+
+	for _, dataSource := range dataSources {
+		normalizedDataSource := normalizeDataSource(dataSource)
+
+		dataSourceID, isOK := dataSourceIDs[normalizedDataSource]
+		if !isOK {
+			senzingConfig.log(3001, normalizedDataSource)
+
+			continue
+		}
+
+		if slices.Contains(removedDataSources, normalizedDataSource) {
+			continue
+		}
+
+		err = senzingConfig.verifyNoRecords(ctx, normalizedDataSource, dataSourceID, force)
+		if err != nil {
+			return "", "", removedDataSources, wraperror.Errorf(err, "verifyNoRecords")
+		}
+
+		removedDataSources = append(removedDataSources, normalizedDataSource)
+	}
+
+	if len(removedDataSources) == 0 {
+		return "", "", removedDataSources, nil
+	}
+
+	// Remove datasources.
+
+	for _, dataSource := range removedDataSources {
+		_, err = szConfig.UnregisterDataSource(ctx, dataSource)
+		if err != nil {
+			return "", "", removedDataSources, wraperror.Errorf(err, "UnregisterDataSource: %s", dataSource)
+		}
+	}
+
+	configDefinition, err := szConfig.Export(ctx)
+	if err != nil {
+		return "", "", removedDataSources, wraperror.Errorf(err, "Export")
+	}
+
+	configComment := senzingConfig.formatConfigComment("Removed", removedDataSources, configDefinition)
+
+	return configDefinition, configComment, removedDataSources, nil
+}
+
+// Return an error if a datasource has records, unless force is true.
+func (senzingConfig *BasicSenzingConfig) verifyNoRecords(
+	ctx context.Context,
+	dataSource string,
+	dataSourceID int64,
+	force bool,
+) error {
+	recordCount, err := senzingConfig.countRecords(ctx, dataSourceID)
+	if err != nil {
+		if force {
+			return nil
+		}
+
+		return wraperror.Errorf(err, "could not count records of datasource %s. Use force to skip this check", dataSource)
+	}
+
+	if recordCount == 0 {
+		return nil
+	}
+
+	if force {
+		senzingConfig.log(3002, dataSource, recordCount)
+
+		return nil
+	}
+
+	return wraperror.Errorf(
+		errForPackage,
+		"datasource %s still has %d record(s). Delete the records or use force",
+		dataSource,
+		recordCount,
+	)
+}
+
+// Count the records of a datasource in the Senzing repository.
+func (senzingConfig *BasicSenzingConfig) countRecords(ctx context.Context, dataSourceID int64) (int64, error) {
+	var result int64
+
+	database, err := senzingConfig.openPrimaryDatabase(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "openPrimaryDatabase")
+	}
+
+	defer func() { _ = database.Close() }()
+
+	// DSRC_ID is an integer, so formatting it into the statement avoids database-specific placeholders.
+
+	sqlStatement := "SELECT COUNT(*) FROM DSRC_RECORD WHERE DSRC_ID = " + strconv.FormatInt(dataSourceID, 10)
+
+	err = database.QueryRowContext(ctx, sqlStatement).Scan(&result)
+
+	return result, wraperror.Errorf(err, "QueryRowContext: %s", sqlStatement)
+}
+
+// Open the first (primary) database of the Senzing settings, which holds records and stored configurations.
+// Through gRPC, the Senzing repository is the one of the server, so the database of the Senzing settings is not opened.
+func (senzingConfig *BasicSenzingConfig) openPrimaryDatabase(ctx context.Context) (*sql.DB, error) {
+	if len(senzingConfig.GrpcTarget) > 0 {
+		return nil, wraperror.Errorf(
+			errForPackage,
+			"the Senzing repository is reached through gRPC at %s; its database cannot be opened directly",
+			senzingConfig.GrpcTarget,
+		)
+	}
+
+	settingsParser, err := settingsparser.New(senzingConfig.SenzingSettings)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "settingsparser.New")
+	}
+
+	databaseURIs, err := settingsParser.GetDatabaseURIs(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "GetDatabaseURIs")
+	}
+
+	if len(databaseURIs) == 0 {
+		return nil, wraperror.Errorf(errForPackage, "no database in Senzing settings")
+	}
+
+	databaseURL, err := helpersettings.BuildSenzingDatabaseURL(databaseURIs[0])
+	if err != nil {
+		return nil, wraperror.Errorf(err, "BuildSenzingDatabaseURL")
+	}
+
+	databaseConnector, err := connector.NewConnector(ctx, databaseURL)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "NewConnector")
+	}
+
+	return sql.OpenDB(databaseConnector), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Return the configurations stored by the Senzing configuration manager.
+func getConfigRegistry(ctx context.Context, szConfigManager senzing.SzConfigManager) (configRegistry, error) {
+	result := configRegistry{}
+
+	registryJSON, err := szConfigManager.GetConfigRegistry(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "GetConfigRegistry")
+	}
+
+	err = json.Unmarshal([]byte(registryJSON), &result)
+	if err != nil {
+		return result, wraperror.Errorf(err, "json.Unmarshal: %s", registryJSON)
+	}
+
+	return result, nil
+}
+
+// Remove fields that describe the Senzing build rather than the configuration.
+func removeVolatileFields(configDefinition string) (string, error) {
+	configuration := map[string]any{}
+
+	err := json.Unmarshal([]byte(configDefinition), &configuration)
+	if err != nil {
+		return configDefinition, wraperror.Errorf(err, "json.Unmarshal")
+	}
+
+	delete(configuration, configMetadataKey)
+
+	if g2Config, isOK := configuration["G2_CONFIG"].(map[string]any); isOK {
+		if configBaseVersion, isOK := g2Config["CONFIG_BASE_VERSION"].(map[string]any); isOK {
+			for _, field := range volatileConfigBaseVersionFields {
+				delete(configBaseVersion, field)
+			}
+		}
+	}
+
+	result, err := json.Marshal(configuration)
+	if err != nil {
+		return configDefinition, wraperror.Errorf(err, "json.Marshal")
+	}
+
+	return string(result), nil
+}
+
+// Return the configuration that should replace the default configuration, or an empty one to keep the default.
+func buildDefaultConfig(
+	ctx context.Context,
+	szConfigManager senzing.SzConfigManager,
+	currentConfigID int64,
+	createConfig func(ctx context.Context) (string, string, error),
+	modifyConfig func(ctx context.Context, szConfig senzing.SzConfig) (string, string, error),
+) (string, string, error) {
+	if currentConfigID == 0 {
+		if createConfig == nil {
+			return "", "", wraperror.Errorf(errForPackage, "no default Senzing configuration")
+		}
+
+		configDefinition, configComment, err := createConfig(ctx)
+
+		return configDefinition, configComment, wraperror.Errorf(err, "createConfig")
+	}
+
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, currentConfigID)
+	if err != nil {
+		return "", "", wraperror.Errorf(err, "CreateConfigFromConfigID: %d", currentConfigID)
+	}
+
+	configDefinition, configComment, err := modifyConfig(ctx, szConfig)
+
+	return configDefinition, configComment, wraperror.Errorf(err, "modifyConfig")
+}
+
+/*
+Make a registered Senzing configuration the default if the default is still currentConfigID.
+A currentConfigID of 0 means there was no default configuration.
+Two processes may both find no default, so the default is read back after it is set.
+A changed default is reported as szerror.ErrSzReplaceConflict.
+*/
+func replaceDefaultConfigID(
+	ctx context.Context,
+	szConfigManager senzing.SzConfigManager,
+	currentConfigID int64,
+	newConfigID int64,
+) error {
+	if currentConfigID != 0 {
+		return szConfigManager.ReplaceDefaultConfigID(ctx, currentConfigID, newConfigID)
+	}
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if defaultConfigID != 0 {
+		return szerror.ErrSzReplaceConflict
+	}
+
+	err = szConfigManager.SetDefaultConfigID(ctx, newConfigID)
+	if err != nil {
+		return wraperror.Errorf(err, "SetDefaultConfigID: %d", newConfigID)
+	}
+
+	defaultConfigID, err = szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if defaultConfigID != newConfigID {
+		return szerror.ErrSzReplaceConflict
+	}
+
+	return nil
+}
+
+// Map datasource codes to datasource identifiers.
+func getDataSourceIDs(registry dataSourceRegistry) map[string]int64 {
+	result := map[string]int64{}
+	for _, dataSource := range registry.DataSources {
+		result[dataSource.DsrcCode] = dataSource.DsrcID
+	}
+
+	return result
+}
+
+// Given a list of datasources, return those not registered in the Senzing configuration.
+func getMissingDataSources(ctx context.Context, szConfig senzing.SzConfig, dataSources []string) ([]string, error) {
+	result := []string{}
+
+	registeredDataSources, err := getRegisteredDataSources(ctx, szConfig)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getRegisteredDataSources")
+	}
+
+	for _, dataSource := range dataSources {
+		normalizedDataSource := normalizeDataSource(dataSource)
+		if !slices.Contains(registeredDataSources, normalizedDataSource) &&
+			!slices.Contains(result, normalizedDataSource) {
+			result = append(result, normalizedDataSource)
+		}
+	}
+
+	return result, nil
+}
+
+// Return the datasources registered in the Senzing configuration.
+func getDataSourceRegistry(ctx context.Context, szConfig senzing.SzConfig) (dataSourceRegistry, error) {
+	result := dataSourceRegistry{}
+
+	registryJSON, err := szConfig.GetDataSourceRegistry(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "GetDataSourceRegistry")
+	}
+
+	err = json.Unmarshal([]byte(registryJSON), &result)
+	if err != nil {
+		return result, wraperror.Errorf(err, "json.Unmarshal: %s", registryJSON)
+	}
+
+	return result, nil
+}
+
+// Return the codes of the datasources registered in the Senzing configuration.
+func getRegisteredDataSources(ctx context.Context, szConfig senzing.SzConfig) ([]string, error) {
+	result := []string{}
+
+	registry, err := getDataSourceRegistry(ctx, szConfig)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getDataSourceRegistry")
+	}
+
+	for _, dataSource := range registry.DataSources {
+		result = append(result, dataSource.DsrcCode)
+	}
+
+	return result, nil
+}
+
+// Datasource codes are upper case, without surrounding whitespace.
+func normalizeDataSource(dataSource string) string {
+	return strings.ToUpper(strings.TrimSpace(dataSource))
+}
+
+// Copy a file, replacing the target if it exists.
+func copyFile(sourceFile string, targetFile string) error {
+	content, err := os.ReadFile(filepath.Clean(sourceFile))
+	if err != nil {
+		return wraperror.Errorf(err, "os.ReadFile: %s", sourceFile)
+	}
+
+	fileInfo, err := os.Stat(sourceFile)
+	if err != nil {
+		return wraperror.Errorf(err, "os.Stat: %s", sourceFile)
+	}
+
+	err = os.WriteFile(filepath.Clean(targetFile), content, fileInfo.Mode().Perm())
+
+	return wraperror.Errorf(err, "os.WriteFile: %s", targetFile)
+}
 
 func fileToString(ctx context.Context, filePath string) (string, error) {
 	_ = ctx
@@ -2,23 +2,71 @@ package senzingconfig_test
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/senzing-garage/go-databasing/connector"
 	"github.com/senzing-garage/go-helpers/env"
 	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/go-helpers/settingsparser"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-sdk-abstract-factory/szfactorycreator"
 	"github.com/senzing-garage/init-database/senzingconfig"
 	"github.com/senzing-garage/init-database/senzingschema"
+	"github.com/senzing-garage/sz-sdk-go-mock/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go-mock/szconfig"
+	"github.com/senzing-garage/sz-sdk-go-mock/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/stretchr/testify/require"
 )
 
 const (
+	configSpecFile = "../testdata/config-spec/config-spec.yaml"
 	observerID     = "Observer 1"
 	observerOrigin = "init-database observer"
 )
 
+// The Senzing configuration template of a fakeSzConfigManager.
+const fakeTemplateDefinition = `{
+  "G2_CONFIG": {
+    "CFG_ATTR": [],
+    "CFG_DSRC": [
+      {"DSRC_ID": 1, "DSRC_CODE": "TEST"},
+      {"DSRC_ID": 2, "DSRC_CODE": "SEARCH"}
+    ],
+    "CFG_FTYPE": [],
+    "CONFIG_BASE_VERSION": {
+      "VERSION": "4.0.0",
+      "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}
+    }
+  }
+}`
+
+const validConfigDefinition = `{
+  "G2_CONFIG": {
+    "CFG_ATTR": [],
+    "CFG_DSRC": [
+      {"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS"},
+      {"DSRC_ID": 1002, "DSRC_CODE": "WATCHLIST"}
+    ],
+    "CFG_FTYPE": [],
+    "CONFIG_BASE_VERSION": {
+      "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}
+    }
+  }
+}`
+
 var (
 	logLevel          = env.GetEnv("SENZING_LOG_LEVEL", "INFO")
 	observerSingleton = &observer.NullObserver{
@@ -31,6 +79,104 @@ var (
 // Test interface functions
 // ----------------------------------------------------------------------------
 
+func TestSenzingConfigImpl_ApplyConfigSpec(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.ConfigSpecFile = configSpecFile
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	err = senzingConfig.ApplyConfigSpec(ctx)
+	require.NoError(test, err)
+	configID := getDefaultConfigID(ctx, test)
+
+	// Applying the same specification again should not create a new configuration.
+
+	err = senzingConfig.ApplyConfigSpec(ctx)
+	require.NoError(test, err)
+	require.Equal(test, configID, getDefaultConfigID(ctx, test))
+}
+
+func TestSenzingConfigImpl_ApplyConfigSpec_missingFile(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.ConfigSpecFile = "/no/such/config-spec.yaml"
+	err := senzingConfig.ApplyConfigSpec(ctx)
+	require.Error(test, err)
+}
+
+func TestSenzingConfigImpl_ExportConfig(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	configDefinition, err := senzingConfig.ExportConfig(ctx, false)
+	require.NoError(test, err)
+	require.Contains(test, configDefinition, "BUILD_VERSION")
+	configDefinition, err = senzingConfig.ExportConfig(ctx, true)
+	require.NoError(test, err)
+	require.Contains(test, configDefinition, "G2_CONFIG")
+	require.NotContains(test, configDefinition, "BUILD_VERSION")
+}
+
+func TestSenzingConfigImpl_GetConfigHistory(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	configHistory, err := senzingConfig.GetConfigHistory(ctx)
+	require.NoError(test, err)
+	require.NotEmpty(test, configHistory)
+	require.True(test, slices.ContainsFunc(configHistory, func(entry senzingconfig.ConfigHistoryEntry) bool {
+		return entry.IsDefault && entry.ConfigID == getDefaultConfigID(ctx, test)
+	}))
+}
+
+func TestSenzingConfigImpl_RenameDataSource(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.DataSources = []string{"RENAME_OLD"}
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	err = senzingConfig.RenameDataSource(ctx, "RENAME_OLD", "rename_new", false)
+	require.NoError(test, err)
+	configDefinition, err := senzingConfig.ExportConfig(ctx, false)
+	require.NoError(test, err)
+	require.Contains(test, configDefinition, `"RENAME_NEW"`)
+	require.NotContains(test, configDefinition, `"RENAME_OLD"`)
+}
+
+func TestSenzingConfigImpl_RenameDataSource_sameName(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	err := senzingConfig.RenameDataSource(ctx, "TEST", "test", false)
+	require.Error(test, err)
+}
+
+func TestSenzingConfigImpl_RollbackConfig(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	previousConfigID := getDefaultConfigID(ctx, test)
+
+	senzingConfig = getTestObject(ctx, test)
+	senzingConfig.DataSources = []string{"ROLLBACK_TEST"}
+	err = senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	require.NotEqual(test, previousConfigID, getDefaultConfigID(ctx, test))
+
+	err = senzingConfig.RollbackConfig(ctx, previousConfigID)
+	require.NoError(test, err)
+	require.Equal(test, previousConfigID, getDefaultConfigID(ctx, test))
+}
+
+func TestSenzingConfigImpl_RollbackConfig_unknownConfigID(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	err := senzingConfig.RollbackConfig(ctx, 1)
+	require.Error(test, err)
+}
+
 func TestSenzingConfigImpl_InitializeSenzing_withDatasources(test *testing.T) {
 	ctx := test.Context()
 	senzingConfig := getTestObject(ctx, test)
@@ -39,6 +185,316 @@ func TestSenzingConfigImpl_InitializeSenzing_withDatasources(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestSenzingConfigImpl_InitializeSenzing_withExistingDatasources(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.DataSources = []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	configID := getDefaultConfigID(ctx, test)
+
+	// Registering the same datasources again should not create a new configuration.
+
+	senzingConfig = getTestObject(ctx, test)
+	senzingConfig.DataSources = []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}
+	err = senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	require.Equal(test, configID, getDefaultConfigID(ctx, test))
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_withNewDatasources(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.DataSources = []string{"CUSTOMERS"}
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	configID := getDefaultConfigID(ctx, test)
+
+	// Registering a new datasource should replace the default configuration.
+
+	senzingConfig = getTestObject(ctx, test)
+	senzingConfig.DataSources = []string{"CUSTOMERS", "EMPLOYEES"}
+	err = senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	require.NotEqual(test, configID, getDefaultConfigID(ctx, test))
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_withConfigFile(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.SenzingConfigJSONFile = getTemplateFile(ctx, test)
+	senzingConfig.DataSources = []string{"CONFIG_FILE_TEST"}
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	configID := getDefaultConfigID(ctx, test)
+
+	// Installing the same file and datasources again should not create a new configuration.
+
+	senzingConfig = getTestObject(ctx, test)
+	senzingConfig.SenzingConfigJSONFile = getTemplateFile(ctx, test)
+	senzingConfig.DataSources = []string{"CONFIG_FILE_TEST"}
+	err = senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	require.Equal(test, configID, getDefaultConfigID(ctx, test))
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_missingConfigFile(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.SenzingConfigJSONFile = test.TempDir() + "/no-such-file.json"
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.Error(test, err)
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_replaceConflict(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	anObserver := &channelObserver{messages: make(chan string, 100)}
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		DataSources: []string{"CONFLICT_TEST"},
+	}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	// Another process registers a datasource between the read and the replace of the first attempt.
+
+	szConfigManager.beforeSetDefault = func(szConfigManager *fakeSzConfigManager) {
+		szConfigManager.beforeSetDefault = nil
+		szConfigManager.addDataSourceToDefault(test, "CONCURRENT_TEST")
+	}
+
+	var errRegister, err error
+
+	output := captureStderr(test, func() {
+		errRegister = senzingConfig.RegisterObserver(ctx, anObserver)
+		err = senzingConfig.InitializeSenzing(ctx)
+	})
+	require.NoError(test, errRegister)
+	require.NoError(test, err)
+	require.Equal(test, 2, szConfigManager.registerCount)
+	require.Contains(test, output, "was changed by another process (attempt 1 of 5)")
+	require.Contains(test, output, "Senzing configuration 2 was registered but not made the default")
+
+	// The retry keeps the concurrent change.
+
+	require.Equal(test, []string{"TEST", "SEARCH", "CONCURRENT_TEST", "CONFLICT_TEST"}, szConfigManager.getDefaultDataSources(test))
+
+	details := getNotifications(test, anObserver, "8013", 1)
+	require.Equal(test, "1", details[0]["attempt"])
+	require.Equal(test, "true", details[0]["isRetrying"])
+	require.Equal(test, "2", details[0]["orphanConfigID"])
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_replaceConflictGivesUp(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	anObserver := &channelObserver{messages: make(chan string, 100)}
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		DataSources: []string{"CONFLICT_TEST"},
+	}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	// Another process changes the default during every attempt, so the update gives up after MaxConfigUpdateAttempts.
+	// Retries wait 100, 200, 300 and 400 milliseconds.
+
+	szConfigManager.beforeSetDefault = func(szConfigManager *fakeSzConfigManager) {
+		szConfigManager.addDataSourceToDefault(test, fmt.Sprintf("CONCURRENT_%d", szConfigManager.registerCount))
+	}
+
+	var errRegister, err error
+
+	entryTime := time.Now()
+	output := captureStderr(test, func() {
+		errRegister = senzingConfig.RegisterObserver(ctx, anObserver)
+		err = senzingConfig.InitializeSenzing(ctx)
+	})
+	require.NoError(test, errRegister)
+	require.ErrorContains(test, err, "default Senzing configuration was changed by another process 5 times")
+	require.GreaterOrEqual(test, time.Since(entryTime), time.Second)
+	require.Equal(test, senzingconfig.MaxConfigUpdateAttempts, szConfigManager.registerCount)
+
+	// Each attempt leaves its registered configuration behind.  Those of the other process are 3, 5, 7, ...
+
+	for attempt := 1; attempt <= senzingconfig.MaxConfigUpdateAttempts; attempt++ {
+		require.Contains(test, output, fmt.Sprintf("(attempt %d of 5)", attempt))
+		require.Contains(test, output, fmt.Sprintf("Senzing configuration %d was registered", 2*attempt))
+	}
+
+	details := getNotifications(test, anObserver, "8013", senzingconfig.MaxConfigUpdateAttempts)
+	slices.SortFunc(details, func(a, b map[string]string) int { return strings.Compare(a["attempt"], b["attempt"]) })
+
+	for index, detail := range details {
+		require.Equal(test, strconv.Itoa(index+1), detail["attempt"])
+		require.Equal(test, strconv.Itoa(2*(index+1)), detail["orphanConfigID"])
+		require.Equal(test, strconv.FormatBool(index+1 < senzingconfig.MaxConfigUpdateAttempts), detail["isRetrying"])
+	}
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_configFileReplaceConflict(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	configFile := test.TempDir() + "/g2config.json"
+	require.NoError(test, os.WriteFile(configFile, []byte(getFakeConfigDefinition(test, "FILE_TEST")), 0o600))
+
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		DataSources:           []string{"CONFLICT_TEST"},
+		SenzingConfigJSONFile: configFile,
+		SenzingSettings:       getFakeSenzingSettings(test),
+	}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	// Another process registers a datasource between the read and the replace of the first attempt.
+
+	szConfigManager.beforeSetDefault = func(szConfigManager *fakeSzConfigManager) {
+		szConfigManager.beforeSetDefault = nil
+		szConfigManager.addDataSourceToDefault(test, "CONCURRENT_TEST")
+	}
+
+	var err error
+
+	output := captureStderr(test, func() {
+		err = senzingConfig.InitializeSenzing(ctx)
+	})
+	require.NoError(test, err)
+	require.Contains(test, output, "was changed by another process (attempt 1 of 5)")
+
+	// The retry registers the datasources in the concurrent default instead of submitting the file again.
+
+	require.Equal(test, []string{"TEST", "SEARCH", "CONCURRENT_TEST", "CONFLICT_TEST"}, szConfigManager.getDefaultDataSources(test))
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_configFileKeepsDataSources(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	szConfigManager.addDataSourceToDefault(test, "EXISTING_TEST")
+
+	configFile := test.TempDir() + "/g2config.json"
+	require.NoError(test, os.WriteFile(configFile, []byte(getFakeConfigDefinition(test, "FILE_TEST")), 0o600))
+
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		DataSources:           []string{"NEW_TEST"},
+		SenzingConfigJSONFile: configFile,
+		SenzingSettings:       getFakeSenzingSettings(test),
+	}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	captureStderr(test, func() {
+		require.NoError(test, senzingConfig.InitializeSenzing(ctx))
+	})
+
+	require.Equal(
+		test,
+		[]string{"TEST", "SEARCH", "FILE_TEST", "EXISTING_TEST", "NEW_TEST"},
+		szConfigManager.getDefaultDataSources(test),
+	)
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_templateConfigFile(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	szConfigManager.addDataSourceToDefault(test, "EXISTING_TEST")
+	defaultConfigID := szConfigManager.defaultConfigID
+	senzingSettings := getFakeSenzingSettings(test)
+
+	settingsParser, err := settingsparser.New(senzingSettings)
+	require.NoError(test, err)
+	resourcePath, err := settingsParser.GetResourcePath(ctx)
+	require.NoError(test, err)
+
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		SenzingConfigJSONFile: resourcePath + "/templates/g2config.json",
+		SenzingSettings:       senzingSettings,
+	}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	// The file is already the template, so the existing default is kept.
+
+	captureStderr(test, func() {
+		require.NoError(test, senzingConfig.InitializeSenzing(ctx))
+	})
+	require.Equal(test, defaultConfigID, szConfigManager.defaultConfigID)
+	require.Zero(test, szConfigManager.registerCount)
+}
+
+func TestSenzingConfigImpl_InitializeSenzing_noDefaultConflict(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	szConfigManager.defaultConfigID = 0
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		DataSources: []string{"CONFLICT_TEST"},
+	}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	// Another process also finds no default configuration, and sets its own default just after this one.
+
+	szConfigManager.afterSetDefault = func(szConfigManager *fakeSzConfigManager) {
+		szConfigManager.afterSetDefault = nil
+		szConfigManager.setDefaultWithDataSource(test, "CONCURRENT_TEST")
+	}
+
+	var err error
+
+	output := captureStderr(test, func() {
+		err = senzingConfig.InitializeSenzing(ctx)
+	})
+	require.NoError(test, err)
+	require.Contains(test, output, "Senzing configuration 2 was registered but not made the default")
+
+	// The retry registers the datasources in the default of the other process.
+
+	require.Equal(test, []string{"TEST", "SEARCH", "CONCURRENT_TEST", "CONFLICT_TEST"}, szConfigManager.getDefaultDataSources(test))
+}
+
+func TestSenzingConfigImpl_UpgradeConfig_replaceConflict(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	anObserver := &channelObserver{messages: make(chan string, 100)}
+	senzingConfig := &senzingconfig.BasicSenzingConfig{}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	// The default was based on an older template without the SEARCH datasource.
+
+	szConfig := newFakeSzConfig(test, fakeTemplateDefinition)
+	_, err := szConfig.UnregisterDataSource(ctx, "SEARCH")
+	require.NoError(test, err)
+	baseConfigDefinition, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+	szConfigManager.defaultConfigID = szConfigManager.store(baseConfigDefinition)
+
+	// Another process registers a datasource between the confirmation and the replace.
+
+	szConfigManager.beforeSetDefault = func(szConfigManager *fakeSzConfigManager) {
+		szConfigManager.beforeSetDefault = nil
+		szConfigManager.addDataSourceToDefault(test, "CONCURRENT_TEST")
+	}
+
+	var (
+		errRegister error
+		result      senzingconfig.ConfigUpgradePlan
+	)
+
+	output := captureStderr(test, func() {
+		errRegister = senzingConfig.RegisterObserver(ctx, anObserver)
+		result, err = senzingConfig.UpgradeConfig(
+			ctx,
+			baseConfigDefinition,
+			func(_ senzingconfig.ConfigUpgradePlan) bool { return true },
+		)
+	})
+	require.NoError(test, errRegister)
+	require.ErrorContains(test, err, "Senzing configuration 3 is not used and can be removed by config prune")
+	require.Zero(test, result.NewConfigID)
+	require.Equal(test, 1, szConfigManager.registerCount)
+	require.Contains(test, output, "Senzing configuration 3 was registered but not made the default")
+
+	// The concurrent change is kept.
+
+	require.Equal(test, []string{"TEST", "CONCURRENT_TEST"}, szConfigManager.getDefaultDataSources(test))
+
+	details := getNotifications(test, anObserver, "8013", 1)
+	require.Equal(test, "false", details[0]["isRetrying"])
+	require.Equal(test, "3", details[0]["orphanConfigID"])
+}
+
 func TestSenzingConfigImpl_InitializeSenzing(test *testing.T) {
 	ctx := test.Context()
 	senzingConfig := getTestObject(ctx, test)
@@ -59,6 +515,17 @@ func TestSenzingConfigImpl_RegisterObserver(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestSenzingConfigImpl_RegisterObserver_notifies(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	anObserver := &channelObserver{messages: make(chan string, 100)}
+	err := senzingConfig.RegisterObserver(ctx, anObserver)
+	require.NoError(test, err)
+
+	details := getNotifications(test, anObserver, "8003", 1)
+	require.Equal(test, observerID, details[0]["observerID"])
+}
+
 func TestSenzingConfigImpl_SetLogLevel(test *testing.T) {
 	ctx := test.Context()
 	senzingConfig := getTestObject(ctx, test)
@@ -72,6 +539,57 @@ func TestSenzingConfigImpl_SetObserverOrigin(test *testing.T) {
 	senzingConfig.SetObserverOrigin(ctx, "TestObserver")
 }
 
+func TestSenzingConfigImpl_UnregisterDataSources(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	senzingConfig.DataSources = []string{"REMOVE_TEST"}
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	err = senzingConfig.UnregisterDataSources(ctx, []string{"REMOVE_TEST", "NOT_REGISTERED"}, false)
+	require.NoError(test, err)
+	configDefinition, err := senzingConfig.ExportConfig(ctx, false)
+	require.NoError(test, err)
+	require.NotContains(test, configDefinition, `"REMOVE_TEST"`)
+}
+
+func TestSenzingConfigImpl_UnregisterDataSources_notRegistered(test *testing.T) {
+	ctx := test.Context()
+	senzingConfig := getTestObject(ctx, test)
+	err := senzingConfig.InitializeSenzing(ctx)
+	require.NoError(test, err)
+	configID := getDefaultConfigID(ctx, test)
+	err = senzingConfig.UnregisterDataSources(ctx, []string{"NOT_REGISTERED"}, false)
+	require.NoError(test, err)
+	require.Equal(test, configID, getDefaultConfigID(ctx, test))
+}
+
+func TestSenzingConfigImpl_UnregisterDataSources_grpc(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := newFakeSzConfigManager(test)
+	szConfigManager.addDataSourceToDefault(test, "GRPC_TEST")
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		GrpcTarget: "localhost:8261",
+	}
+	senzingconfig.SetSzAbstractFactory(senzingConfig, newFakeSzAbstractFactory(szConfigManager))
+
+	// Records cannot be counted through gRPC, so removing a datasource needs force.
+
+	var err error
+
+	captureStderr(test, func() {
+		err = senzingConfig.UnregisterDataSources(ctx, []string{"GRPC_TEST"}, false)
+	})
+	require.ErrorContains(test, err, "reached through gRPC at localhost:8261")
+	require.ErrorContains(test, err, "Use force to skip this check")
+	require.Contains(test, szConfigManager.getDefaultDataSources(test), "GRPC_TEST")
+
+	captureStderr(test, func() {
+		err = senzingConfig.UnregisterDataSources(ctx, []string{"GRPC_TEST"}, true)
+	})
+	require.NoError(test, err)
+	require.NotContains(test, szConfigManager.getDefaultDataSources(test), "GRPC_TEST")
+}
+
 func TestSenzingConfigImpl_UnregisterObserver(test *testing.T) {
 	ctx := test.Context()
 	senzingConfig := getTestObject(ctx, test)
@@ -85,10 +603,730 @@ func TestSenzingConfigImpl_UnregisterObserver(test *testing.T) {
 	require.NoError(test, err)
 }
 
+// ----------------------------------------------------------------------------
+// Test public functions
+// ----------------------------------------------------------------------------
+
+func TestBuildConfigSourceSettings(test *testing.T) {
+	senzingSettings := `{
+		"PIPELINE": {"CONFIGPATH": "/etc/opt/senzing"},
+		"SQL": {"BACKEND": "SQL", "CONNECTION": "sqlite3://na:na@/tmp/target.db"}
+	}`
+	result, err := senzingconfig.BuildConfigSourceSettings(senzingSettings, "sqlite3://na:na@nowhere/tmp/source.db")
+	require.NoError(test, err)
+	require.JSONEq(
+		test,
+		`{"PIPELINE":{"CONFIGPATH":"/etc/opt/senzing"},"SQL":{"CONNECTION":"sqlite3://na:na@nowhere/tmp/source.db"}}`,
+		result,
+	)
+}
+
+func TestBuildConfigSourceSettings_settingsJSON(test *testing.T) {
+	configSource := `{"SQL":{"CONNECTION":"sqlite3://na:na@/tmp/source.db"}}`
+	result, err := senzingconfig.BuildConfigSourceSettings(`{}`, configSource)
+	require.NoError(test, err)
+	require.Equal(test, configSource, result)
+}
+
+func TestBuildConfigSourceSettings_badDatabaseURL(test *testing.T) {
+	_, err := senzingconfig.BuildConfigSourceSettings(`{}`, "unknown://na:na@nowhere/tmp/source.db")
+	require.Error(test, err)
+}
+
+func TestLoadDataSourcesFile(test *testing.T) {
+	dataSources, err := senzingconfig.LoadDataSourcesFile("../testdata/datasources/datasources.txt")
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}, dataSources)
+}
+
+func TestLoadDataSourcesFile_yaml(test *testing.T) {
+	dataSources, err := senzingconfig.LoadDataSourcesFile("../testdata/datasources/datasources.yaml")
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}, dataSources)
+}
+
+func TestMergeDataSources(test *testing.T) {
+	dataSources := senzingconfig.MergeDataSources([]string{"customers", " "}, []string{"WATCHLIST", "CUSTOMERS"})
+	require.Equal(test, []string{"CUSTOMERS", "WATCHLIST"}, dataSources)
+}
+
+func TestParseDataSources_json(test *testing.T) {
+	dataSources, err := senzingconfig.ParseDataSources([]byte(`["CUSTOMERS", "watchlist"]`))
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS", "WATCHLIST"}, dataSources)
+}
+
+func TestParseDataSources_invalid(test *testing.T) {
+	_, err := senzingconfig.ParseDataSources([]byte("CUSTOMERS\nMY CUSTOMERS\n"))
+	require.ErrorContains(test, err, "line 2")
+}
+
+func TestValidateDataSourceCodes(test *testing.T) {
+	err := senzingconfig.ValidateDataSourceCodes([]string{"CUSTOMERS", "WATCH-LIST", "REFERENCE_2"})
+	require.NoError(test, err)
+}
+
+func TestValidateDataSourceCodes_invalid(test *testing.T) {
+	err := senzingconfig.ValidateDataSourceCodes([]string{
+		"customers",
+		"NULL",
+		"A_DATASOURCE_CODE_THAT_IS_TOO_LONG",
+		"ÉTAT",
+	})
+	require.ErrorContains(test, err, "contains lower case letters")
+	require.ErrorContains(test, err, "NULL_DATA")
+	require.ErrorContains(test, err, "is longer than 25 characters")
+	require.ErrorContains(test, err, "A_DATASOURCE_CODE_THAT_IS")
+	require.ErrorContains(test, err, "contains characters other than A-Z")
+}
+
+func TestParseConfigSpec(test *testing.T) {
+	configSpec, err := senzingconfig.LoadConfigSpec(configSpecFile)
+	require.NoError(test, err)
+	require.Equal(test, []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}, configSpec.DataSources)
+	require.Len(test, configSpec.Features, 1)
+	require.Len(test, configSpec.Attributes, 1)
+}
+
+func TestParseConfigSpec_json(test *testing.T) {
+	configSpec, err := senzingconfig.ParseConfigSpec([]byte(`{"dataSources": ["TEST"], "rules": [{"ERRULE_CODE": "X"}]}`))
+	require.NoError(test, err)
+	require.Equal(test, []string{"TEST"}, configSpec.DataSources)
+	require.Equal(test, "X", configSpec.Rules[0]["ERRULE_CODE"])
+}
+
+func TestParseConfigSpec_unknownSection(test *testing.T) {
+	_, err := senzingconfig.ParseConfigSpec([]byte("dataSorces:\n  - TEST\n"))
+	require.Error(test, err)
+}
+
+func TestParseConfigSpec_missingKey(test *testing.T) {
+	_, err := senzingconfig.ParseConfigSpec([]byte("features:\n  - FTYPE_DESC: No code\n"))
+	require.Error(test, err)
+}
+
+func TestValidateConfigDefinition(test *testing.T) {
+	err := senzingconfig.ValidateConfigDefinition(validConfigDefinition, "11")
+	require.NoError(test, err)
+}
+
+func TestValidateConfigDefinition_badJSON(test *testing.T) {
+	err := senzingconfig.ValidateConfigDefinition("{\n  \"G2_CONFIG\": {,\n}", "11")
+	require.ErrorContains(test, err, "line 2, column 17")
+}
+
+func TestValidateConfigDefinition_missingG2Config(test *testing.T) {
+	err := senzingconfig.ValidateConfigDefinition(`{"CFG_DSRC": []}`, "11")
+	require.ErrorContains(test, err, "$.G2_CONFIG:")
+}
+
+func TestValidateConfigDefinition_duplicateDataSource(test *testing.T) {
+	configDefinition := strings.Replace(validConfigDefinition, `"WATCHLIST"`, `"customers"`, 1)
+	err := senzingconfig.ValidateConfigDefinition(configDefinition, "11")
+	require.ErrorContains(test, err, "$.G2_CONFIG.CFG_DSRC[1].DSRC_CODE: duplicate datasource code customers")
+}
+
+func TestValidateConfigDefinition_wrongVersion(test *testing.T) {
+	err := senzingconfig.ValidateConfigDefinition(validConfigDefinition, "10")
+	require.ErrorContains(test, err, "$.G2_CONFIG.CONFIG_BASE_VERSION.COMPATIBILITY_VERSION.CONFIG_VERSION")
+}
+
+func TestCompareConfigs(test *testing.T) {
+	configDefinition1 := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Test"},{"DSRC_CODE":"WATCHLIST","DSRC_DESC":"Watchlist"}],"CFG_FBOM":[{"FTYPE_ID":1,"FELEM_ID":2}],"CONFIG_BASE_VERSION":{"BUILD_NUMBER":"1"}}}`
+	configDefinition2 := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Changed"},{"DSRC_CODE":"CUSTOMERS","DSRC_DESC":"Customers"}],"CFG_FBOM":[],"CONFIG_BASE_VERSION":{"BUILD_NUMBER":"2"}}}`
+	configDiff, err := senzingconfig.CompareConfigs(configDefinition1, configDefinition2)
+	require.NoError(test, err)
+	require.Len(test, configDiff.Sections, 6)
+	dataSources := configDiff.Sections[0]
+	require.Equal(test, "datasources", dataSources.Name)
+	require.Equal(test, []string{"DSRC_CODE=CUSTOMERS"}, dataSources.Added)
+	require.Equal(test, []string{"DSRC_CODE=WATCHLIST"}, dataSources.Removed)
+	require.Equal(
+		test,
+		[]senzingconfig.ConfigDiffChange{{Field: "DSRC_DESC", Key: "DSRC_CODE=TEST", NewValue: "Changed", OldValue: "Test"}},
+		dataSources.Updated,
+	)
+	require.Empty(test, configDiff.Sections[1].Added)
+	other := configDiff.Sections[5]
+	require.Equal(test, "other", other.Name)
+	require.Equal(test, []string{"CFG_FBOM FTYPE_ID=1,FELEM_ID=2"}, other.Removed)
+	require.Empty(test, other.Updated)
+}
+
+func TestCompareConfigs_badJSON(test *testing.T) {
+	_, err := senzingconfig.CompareConfigs(`{}`, `{"G2_CONFIG":`)
+	require.Error(test, err)
+}
+
+func TestFormatConfigComment(test *testing.T) {
+	configComment := senzingconfig.FormatConfigComment(
+		"{ticket}: {operation} by {operator} with {datasources} on Senzing {version} {unknown}",
+		"Created",
+		[]string{"CUSTOMERS", "WATCHLIST"},
+		"4.0.0",
+		map[string]string{"operator": "jdoe", "ticket": "OPS-42"},
+	)
+	require.Equal(test, "OPS-42: Created by jdoe with CUSTOMERS WATCHLIST on Senzing 4.0.0 {unknown}", configComment)
+}
+
+func TestFormatConfigComment_default(test *testing.T) {
+	configComment := senzingconfig.FormatConfigComment("", "Created", []string{"TEST"}, "", nil)
+	require.True(test, strings.HasPrefix(configComment, "Created by init-database at "))
+	require.True(test, strings.HasSuffix(configComment, " with datasources: TEST "))
+}
+
+func TestFormatConfigComment_defaultWithoutDatasources(test *testing.T) {
+	configComment := senzingconfig.FormatConfigComment("", "Renamed datasource OLD to NEW", nil, "", nil)
+	require.True(test, strings.HasPrefix(configComment, "Renamed datasource OLD to NEW by init-database at "))
+	require.NotContains(test, configComment, "with datasources")
+}
+
+func TestParseConfigLabels(test *testing.T) {
+	configLabels, err := senzingconfig.ParseConfigLabels([]string{"sha=abc123", " env = prod ", "url=http://x?a=b"})
+	require.NoError(test, err)
+	require.Equal(test, map[string]string{"env": "prod", "sha": "abc123", "url": "http://x?a=b"}, configLabels)
+}
+
+func TestParseConfigLabels_invalid(test *testing.T) {
+	_, err := senzingconfig.ParseConfigLabels([]string{"sha", "=value", "bad key=value"})
+	require.ErrorContains(test, err, "sha: must be key=value")
+	require.ErrorContains(test, err, "=value: key must be non-empty")
+	require.ErrorContains(test, err, "bad key=value: key must be non-empty")
+}
+
+func TestPlanConfigPrune(test *testing.T) {
+	configHistory := []senzingconfig.ConfigHistoryEntry{
+		{ConfigID: 1, CreatedOn: "2024-01-01 00:00:00.000"},
+		{ConfigID: 2, CreatedOn: "2024-01-02 00:00:00.000", Labels: map[string]string{"pinned": "true"}},
+		{ConfigID: 3, CreatedOn: "2024-01-03 00:00:00.000", IsDefault: true},
+		{ConfigID: 4, CreatedOn: "2024-01-04 00:00:00.000"},
+		{ConfigID: 5, CreatedOn: "2024-01-05 00:00:00.000"},
+		{ConfigID: 6, CreatedOn: "2024-01-06 00:00:00.000"},
+	}
+	configPrunePlan := senzingconfig.PlanConfigPrune(configHistory, 1, []int64{4})
+	kept := map[int64]string{}
+
+	for _, configPruneEntry := range configPrunePlan.Kept {
+		kept[configPruneEntry.ConfigID] = configPruneEntry.Reason
+	}
+
+	require.Equal(test, map[int64]string{2: "pinned", 3: "default", 4: "pinned", 6: "recent"}, kept)
+	require.Len(test, configPrunePlan.Removed, 2)
+	require.Equal(test, int64(5), configPrunePlan.Removed[0].ConfigID)
+	require.Equal(test, int64(1), configPrunePlan.Removed[1].ConfigID)
+}
+
+func TestPlanConfigPrune_keepNone(test *testing.T) {
+	configHistory := []senzingconfig.ConfigHistoryEntry{
+		{ConfigID: 1, CreatedOn: "2024-01-01 00:00:00.000", IsDefault: true},
+		{ConfigID: 2, CreatedOn: "2024-01-02 00:00:00.000"},
+	}
+	configPrunePlan := senzingconfig.PlanConfigPrune(configHistory, 0, nil)
+	require.Len(test, configPrunePlan.Kept, 1)
+	require.Equal(test, int64(1), configPrunePlan.Kept[0].ConfigID)
+	require.Equal(test, int64(2), configPrunePlan.Removed[0].ConfigID)
+}
+
+func TestDeleteConfigs_defaultChanged(test *testing.T) {
+	ctx := test.Context()
+	databaseURL := "sqlite3://na:na@nowhere" + test.TempDir() + "/G2C.db"
+	database := openTestDatabase(ctx, test, databaseURL)
+
+	for _, sqlStatement := range []string{
+		"CREATE TABLE SYS_CFG (CONFIG_DATA_ID BIGINT NOT NULL, CONFIG_DATA CLOB NOT NULL, PRIMARY KEY(CONFIG_DATA_ID))",
+		"CREATE TABLE SYS_VARS (VAR_GROUP VARCHAR(25) NOT NULL, VAR_CODE VARCHAR(25) NOT NULL, VAR_VALUE VARCHAR(25) NOT NULL)",
+		"INSERT INTO SYS_CFG (CONFIG_DATA_ID, CONFIG_DATA) VALUES (1, '{}'), (2, '{}'), (3, '{}')",
+		"INSERT INTO SYS_VARS (VAR_GROUP, VAR_CODE, VAR_VALUE) VALUES ('CONFIG', 'DEFAULT_CONFIG_ID', '1')",
+	} {
+		_, err := database.ExecContext(ctx, sqlStatement)
+		require.NoError(test, err)
+	}
+
+	databaseURI, err := settings.BuildSenzingDatabaseURI(databaseURL)
+	require.NoError(test, err)
+	senzingSettings, err := json.Marshal(map[string]any{"SQL": map[string]string{"CONNECTION": databaseURI}})
+	require.NoError(test, err)
+
+	senzingConfig := &senzingconfig.BasicSenzingConfig{
+		SenzingSettings: string(senzingSettings),
+	}
+
+	// The prune was planned while 1 was the default.  Then another process made 3 the default.
+
+	_, err = database.ExecContext(ctx, "UPDATE SYS_VARS SET VAR_VALUE = '3' WHERE VAR_CODE = 'DEFAULT_CONFIG_ID'")
+	require.NoError(test, err)
+
+	err = senzingconfig.DeleteConfigs(ctx, senzingConfig, []int64{2, 3})
+	require.ErrorContains(test, err, "Senzing configuration 3 is the default")
+
+	var count int
+
+	require.NoError(test, database.QueryRowContext(ctx, "SELECT COUNT(*) FROM SYS_CFG").Scan(&count))
+	require.Equal(test, 3, count)
+
+	err = senzingconfig.DeleteConfigs(ctx, senzingConfig, []int64{1, 2})
+	require.NoError(test, err)
+	require.NoError(test, database.QueryRowContext(ctx, "SELECT COUNT(*) FROM SYS_CFG").Scan(&count))
+	require.Equal(test, 1, count)
+}
+
+func TestCompareDataSources(test *testing.T) {
+	configDiff := senzingconfig.CompareDataSources(
+		[]string{"customers", "WATCHLIST"},
+		[]string{"TEST", "SEARCH", "CUSTOMERS", "REFERENCE"},
+		[]string{"TEST", "SEARCH"},
+	)
+	require.Len(test, configDiff.Sections, 1)
+	require.Equal(test, "datasources", configDiff.Sections[0].Name)
+	require.Equal(test, []string{"WATCHLIST"}, configDiff.Sections[0].Removed)
+	require.Equal(test, []string{"REFERENCE"}, configDiff.Sections[0].Added)
+	require.True(test, senzingconfig.HasConfigDifferences(configDiff))
+}
+
+func TestCompareDataSources_same(test *testing.T) {
+	configDiff := senzingconfig.CompareDataSources(
+		[]string{"CUSTOMERS"},
+		[]string{"TEST", "CUSTOMERS"},
+		[]string{"TEST"},
+	)
+	require.False(test, senzingconfig.HasConfigDifferences(configDiff))
+}
+
+func TestMergeConfigs(test *testing.T) {
+	baseDefinition := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Test"}],"CFG_ATTR":[{"ATTR_CODE":"NAME","FTYPE_CODE":"NAME"}]}}`
+	currentDefinition := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Test"},{"DSRC_CODE":"CUSTOMERS","DSRC_DESC":"Customers"}],"CFG_ATTR":[{"ATTR_CODE":"NAME","FTYPE_CODE":"NAME"}]}}`
+	newDefinition := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Test"}],"CFG_ATTR":[{"ATTR_CODE":"NAME","FTYPE_CODE":"NAME"},{"ATTR_CODE":"EMAIL","FTYPE_CODE":"EMAIL"}]}}`
+	mergedDefinition, changes, conflicts, err := senzingconfig.MergeConfigs(baseDefinition, currentDefinition, newDefinition)
+	require.NoError(test, err)
+	require.Equal(test, []string{"added CFG_ATTR ATTR_CODE=EMAIL"}, changes)
+	require.Empty(test, conflicts)
+	require.Contains(test, mergedDefinition, `"DSRC_CODE":"CUSTOMERS"`)
+	require.Contains(test, mergedDefinition, `"ATTR_CODE":"EMAIL"`)
+}
+
+func TestMergeConfigs_conflict(test *testing.T) {
+	baseDefinition := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Test","DSRC_RELY":1}]}}`
+	currentDefinition := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Mine","DSRC_RELY":1}]}}`
+	newDefinition := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_DESC":"Theirs","DSRC_RELY":2}]}}`
+	mergedDefinition, changes, conflicts, err := senzingconfig.MergeConfigs(baseDefinition, currentDefinition, newDefinition)
+	require.NoError(test, err)
+	require.Equal(test, []string{"updated CFG_DSRC DSRC_CODE=TEST (DSRC_RELY)"}, changes)
+	require.Len(test, conflicts, 1)
+	require.Equal(test, "DSRC_DESC", conflicts[0].Field)
+	require.Equal(test, "Mine", conflicts[0].CurrentValue)
+	require.Contains(test, mergedDefinition, `"DSRC_DESC":"Mine"`)
+	require.Contains(test, mergedDefinition, `"DSRC_RELY":2`)
+}
+
+func TestMergeConfigs_badJSON(test *testing.T) {
+	_, _, _, err := senzingconfig.MergeConfigs(`{}`, `{"G2_CONFIG":`, `{}`)
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Test types
+// ----------------------------------------------------------------------------
+
+// An observer that sends its messages to a channel.
+type channelObserver struct {
+	messages chan string
+}
+
+func (anObserver *channelObserver) GetObserverID(ctx context.Context) string {
+	_ = ctx
+
+	return observerID
+}
+
+func (anObserver *channelObserver) UpdateObserver(ctx context.Context, message string) {
+	_ = ctx
+	anObserver.messages <- message
+}
+
+// An abstract factory whose configuration manager is a fakeSzConfigManager.
+type fakeSzAbstractFactory struct {
+	*szabstractfactory.Szabstractfactory
+
+	szConfigManager *fakeSzConfigManager
+}
+
+func newFakeSzAbstractFactory(szConfigManager *fakeSzConfigManager) *fakeSzAbstractFactory {
+	return &fakeSzAbstractFactory{
+		Szabstractfactory: &szabstractfactory.Szabstractfactory{
+			GetVersionResult: `{"VERSION": "4.0.0", "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}}`,
+		},
+		szConfigManager: szConfigManager,
+	}
+}
+
+func (factory *fakeSzAbstractFactory) CreateConfigManager(ctx context.Context) (senzing.SzConfigManager, error) {
+	_ = ctx
+
+	return factory.szConfigManager, nil
+}
+
+// A Senzing configuration kept in memory.  Only its datasources can be changed.
+type fakeSzConfig struct {
+	*szconfig.Szconfig
+
+	dataSources []string
+	g2Config    map[string]any
+}
+
+func newFakeSzConfig(t *testing.T, configDefinition string) *fakeSzConfig {
+	t.Helper()
+
+	configuration := struct {
+		G2Config map[string]any `json:"G2_CONFIG"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(configDefinition), &configuration))
+
+	result := &fakeSzConfig{
+		Szconfig: &szconfig.Szconfig{},
+		g2Config: configuration.G2Config,
+	}
+
+	cfgDsrc, _ := configuration.G2Config["CFG_DSRC"].([]any)
+	for _, row := range cfgDsrc {
+		dataSource, _ := row.(map[string]any)
+		result.dataSources = append(result.dataSources, dataSource["DSRC_CODE"].(string))
+	}
+
+	return result
+}
+
+func (szConfig *fakeSzConfig) Export(ctx context.Context) (string, error) {
+	_ = ctx
+	g2Config := maps.Clone(szConfig.g2Config)
+	g2Config["CFG_DSRC"] = szConfig.getDataSourceRows()
+	result, err := json.Marshal(map[string]any{"G2_CONFIG": g2Config})
+
+	return string(result), err
+}
+
+func (szConfig *fakeSzConfig) GetDataSourceRegistry(ctx context.Context) (string, error) {
+	_ = ctx
+	result, err := json.Marshal(map[string]any{"DATA_SOURCES": szConfig.getDataSourceRows()})
+
+	return string(result), err
+}
+
+func (szConfig *fakeSzConfig) RegisterDataSource(ctx context.Context, dataSourceCode string) (string, error) {
+	_ = ctx
+
+	if !slices.Contains(szConfig.dataSources, dataSourceCode) {
+		szConfig.dataSources = append(szConfig.dataSources, dataSourceCode)
+	}
+
+	return fmt.Sprintf(`{"DSRC_ID": %d}`, slices.Index(szConfig.dataSources, dataSourceCode)+1), nil
+}
+
+func (szConfig *fakeSzConfig) UnregisterDataSource(ctx context.Context, dataSourceCode string) (string, error) {
+	_ = ctx
+	szConfig.dataSources = slices.DeleteFunc(szConfig.dataSources, func(code string) bool { return code == dataSourceCode })
+
+	return "", nil
+}
+
+func (szConfig *fakeSzConfig) getDataSourceRows() []map[string]any {
+	result := []map[string]any{}
+	for index, dataSource := range szConfig.dataSources {
+		result = append(result, map[string]any{"DSRC_CODE": dataSource, "DSRC_ID": index + 1})
+	}
+
+	return result
+}
+
+// A configuration repository kept in memory.  Configuration IDs are 1, 2, 3, ...
+// Unless beforeSetDefault changes it, the default is the template, with ID 1.
+type fakeSzConfigManager struct {
+	*szconfigmanager.Szconfigmanager
+
+	// Called after the default is set, to simulate another process setting the default at the same time.
+	afterSetDefault func(szConfigManager *fakeSzConfigManager)
+	// Called before the default is replaced or set, to simulate another process changing the default.
+	beforeSetDefault func(szConfigManager *fakeSzConfigManager)
+	configs          []string
+	defaultConfigID  int64
+	registerCount    int
+	t                *testing.T
+}
+
+func newFakeSzConfigManager(t *testing.T) *fakeSzConfigManager {
+	t.Helper()
+
+	result := &fakeSzConfigManager{
+		Szconfigmanager: &szconfigmanager.Szconfigmanager{},
+		t:               t,
+	}
+	result.defaultConfigID = result.store(fakeTemplateDefinition)
+
+	return result
+}
+
+func (szConfigManager *fakeSzConfigManager) CreateConfigFromConfigID(
+	ctx context.Context,
+	configID int64,
+) (senzing.SzConfig, error) {
+	_ = ctx
+
+	return newFakeSzConfig(szConfigManager.t, szConfigManager.configs[configID-1]), nil
+}
+
+func (szConfigManager *fakeSzConfigManager) CreateConfigFromString(
+	ctx context.Context,
+	configDefinition string,
+) (senzing.SzConfig, error) {
+	_ = ctx
+
+	return newFakeSzConfig(szConfigManager.t, configDefinition), nil
+}
+
+func (szConfigManager *fakeSzConfigManager) CreateConfigFromTemplate(ctx context.Context) (senzing.SzConfig, error) {
+	_ = ctx
+
+	return newFakeSzConfig(szConfigManager.t, fakeTemplateDefinition), nil
+}
+
+func (szConfigManager *fakeSzConfigManager) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	_ = ctx
+
+	return szConfigManager.defaultConfigID, nil
+}
+
+func (szConfigManager *fakeSzConfigManager) RegisterConfig(
+	ctx context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	_, _ = ctx, configComment
+	szConfigManager.registerCount++
+
+	return szConfigManager.store(configDefinition), nil
+}
+
+func (szConfigManager *fakeSzConfigManager) ReplaceDefaultConfigID(
+	ctx context.Context,
+	currentDefaultConfigID int64,
+	newDefaultConfigID int64,
+) error {
+	_ = ctx
+
+	if szConfigManager.beforeSetDefault != nil {
+		szConfigManager.beforeSetDefault(szConfigManager)
+	}
+
+	if szConfigManager.defaultConfigID != currentDefaultConfigID {
+		return szerror.ErrSzReplaceConflict
+	}
+
+	szConfigManager.defaultConfigID = newDefaultConfigID
+
+	return nil
+}
+
+func (szConfigManager *fakeSzConfigManager) SetDefaultConfig(
+	ctx context.Context,
+	configDefinition string,
+	configComment string,
+) (int64, error) {
+	configID, err := szConfigManager.RegisterConfig(ctx, configDefinition, configComment)
+	if err != nil {
+		return configID, err
+	}
+
+	return configID, szConfigManager.SetDefaultConfigID(ctx, configID)
+}
+
+func (szConfigManager *fakeSzConfigManager) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	_ = ctx
+
+	if szConfigManager.beforeSetDefault != nil {
+		szConfigManager.beforeSetDefault(szConfigManager)
+	}
+
+	szConfigManager.defaultConfigID = configID
+
+	if szConfigManager.afterSetDefault != nil {
+		szConfigManager.afterSetDefault(szConfigManager)
+	}
+
+	return nil
+}
+
+// As another process would, register a datasource in the default configuration.
+func (szConfigManager *fakeSzConfigManager) addDataSourceToDefault(t *testing.T, dataSource string) {
+	t.Helper()
+
+	szConfig := newFakeSzConfig(t, szConfigManager.configs[szConfigManager.defaultConfigID-1])
+	_, err := szConfig.RegisterDataSource(t.Context(), dataSource)
+	require.NoError(t, err)
+	configDefinition, err := szConfig.Export(t.Context())
+	require.NoError(t, err)
+	szConfigManager.defaultConfigID = szConfigManager.store(configDefinition)
+}
+
+// As another process would, make the template with a datasource registered the default configuration.
+func (szConfigManager *fakeSzConfigManager) setDefaultWithDataSource(t *testing.T, dataSource string) {
+	t.Helper()
+
+	szConfigManager.defaultConfigID = szConfigManager.store(getFakeConfigDefinition(t, dataSource))
+}
+
+func (szConfigManager *fakeSzConfigManager) getDefaultDataSources(t *testing.T) []string {
+	t.Helper()
+
+	if szConfigManager.defaultConfigID == 0 {
+		return nil
+	}
+
+	return newFakeSzConfig(t, szConfigManager.configs[szConfigManager.defaultConfigID-1]).dataSources
+}
+
+func (szConfigManager *fakeSzConfigManager) store(configDefinition string) int64 {
+	szConfigManager.configs = append(szConfigManager.configs, configDefinition)
+
+	return int64(len(szConfigManager.configs))
+}
+
 // ----------------------------------------------------------------------------
 // Helper functions
 // ----------------------------------------------------------------------------
 
+// Run a function and return what it logged.
+// A BasicSenzingConfig logs to os.Stderr as it was when its first method was called.
+func captureStderr(t *testing.T, function func()) string {
+	t.Helper()
+
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+
+	output := make(chan string)
+
+	go func() {
+		contents, _ := io.ReadAll(reader)
+		output <- string(contents)
+	}()
+
+	stderr := os.Stderr
+	os.Stderr = writer
+
+	defer func() { os.Stderr = stderr }()
+
+	function()
+
+	require.NoError(t, writer.Close())
+
+	return <-output
+}
+
+func getDefaultConfigID(ctx context.Context, t *testing.T) int64 {
+	t.Helper()
+
+	senzingSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(t, err)
+
+	szAbstractFactory, err := szfactorycreator.CreateCoreAbstractFactory(
+		"senzingconfig test",
+		senzingSettings,
+		senzing.SzNoLogging,
+		senzing.SzInitializeWithDefaultConfiguration,
+	)
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, szAbstractFactory.Close(ctx)) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(t, err)
+
+	result, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(t, err)
+
+	return result
+}
+
+// The Senzing configuration template, used as an engine configuration file that leaves the template unchanged.
+func getTemplateFile(ctx context.Context, t *testing.T) string {
+	t.Helper()
+
+	senzingSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(t, err)
+
+	settingsParser, err := settingsparser.New(senzingSettings)
+	require.NoError(t, err)
+
+	resourcePath, err := settingsParser.GetResourcePath(ctx)
+	require.NoError(t, err)
+
+	return resourcePath + "/templates/g2config.json"
+}
+
+// Open a database that is closed when the test ends.
+func openTestDatabase(ctx context.Context, t *testing.T, databaseURL string) *sql.DB {
+	t.Helper()
+
+	databaseConnector, err := connector.NewConnector(ctx, databaseURL)
+	require.NoError(t, err)
+
+	result := sql.OpenDB(databaseConnector)
+	t.Cleanup(func() { _ = result.Close() })
+
+	return result
+}
+
+// Return the template of a fakeSzConfigManager with datasources registered.
+func getFakeConfigDefinition(t *testing.T, dataSources ...string) string {
+	t.Helper()
+
+	szConfig := newFakeSzConfig(t, fakeTemplateDefinition)
+	for _, dataSource := range dataSources {
+		_, err := szConfig.RegisterDataSource(t.Context(), dataSource)
+		require.NoError(t, err)
+	}
+
+	result, err := szConfig.Export(t.Context())
+	require.NoError(t, err)
+
+	return result
+}
+
+// Return Senzing settings whose resource path holds the template of a fakeSzConfigManager.
+func getFakeSenzingSettings(t *testing.T) string {
+	t.Helper()
+
+	resourcePath := t.TempDir()
+	require.NoError(t, os.MkdirAll(resourcePath+"/templates", 0o750))
+	require.NoError(t, os.WriteFile(resourcePath+"/templates/g2config.json", []byte(fakeTemplateDefinition), 0o600))
+
+	result, err := json.Marshal(map[string]any{"PIPELINE": map[string]string{"RESOURCEPATH": resourcePath}})
+	require.NoError(t, err)
+
+	return string(result)
+}
+
+// Wait for count notifications with messageID and return their details.
+func getNotifications(t *testing.T, anObserver *channelObserver, messageID string, count int) []map[string]string {
+	t.Helper()
+
+	var result []map[string]string
+
+	for len(result) < count {
+		select {
+		case message := <-anObserver.messages:
+			details := map[string]string{}
+			require.NoError(t, json.Unmarshal([]byte(message), &details))
+
+			if details["messageId"] == messageID {
+				result = append(result, details)
+			}
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "timed out waiting for notifications", messageID)
+		}
+	}
+
+	return result
+}
+
 func getTestObject(ctx context.Context, t *testing.T) *senzingconfig.BasicSenzingConfig {
 	t.Helper()
 
@@ -0,0 +1,4 @@
+/*
+Package senzingdoctor diagnoses the environment used to initialize Senzing databases.
+*/
+package senzingdoctor
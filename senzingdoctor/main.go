@@ -0,0 +1,102 @@
+package senzingdoctor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type SenzingDoctor interface {
+	Diagnose(ctx context.Context) ([]CheckResult, error)
+	RegisterObserver(ctx context.Context, observer observer.Observer) error
+	SetLogLevel(ctx context.Context, logLevelName string) error
+	SetObserverOrigin(ctx context.Context, origin string)
+	UnregisterObserver(ctx context.Context, observer observer.Observer) error
+}
+
+// CheckResult is the outcome of a single diagnostic check.
+type CheckResult struct {
+	Check  string `json:"check"`
+	Detail string `json:"detail,omitempty"`
+	Status string `json:"status"`
+	Target string `json:"target,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identifier of the  package found messages having the format "senzing-6505xxxx".
+const ComponentID = 6505
+
+// Log message prefix.
+const Prefix = "init-database.senzingdoctor."
+
+const (
+	OptionCallerSkip4 = 4
+	OptionCallerSkip5 = 5
+)
+
+// Values of CheckResult.Status.
+const (
+	StatusFail = "fail"
+	StatusPass = "pass"
+	StatusWarn = "warn"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Message templates for senzingdoctor implementation.
+var IDMessages = map[int]string{
+	10:   "Enter " + Prefix + "Diagnose().",
+	11:   "Exit  " + Prefix + "Diagnose(); json.Marshal failed; returned (%v).",
+	19:   "Exit  " + Prefix + "Diagnose() returned (%v).",
+	20:   "Enter " + Prefix + "RegisterObserver(%s).",
+	21:   "Exit  " + Prefix + "RegisterObserver(%s); json.Marshal failed; returned (%v).",
+	22:   "Exit  " + Prefix + "RegisterObserver(%s); senzingDoctor.observers.RegisterObserver failed; returned (%v).",
+	29:   "Exit  " + Prefix + "RegisterObserver(%s) returned (%v).",
+	30:   "Enter " + Prefix + "SetLogLevel(%s).",
+	31:   "Exit  " + Prefix + "SetLogLevel(%s); json.Marshal failed; returned (%v).",
+	32:   "Exit  " + Prefix + "SetLogLevel(%s); logging.IsValidLogLevelName failed; returned (%v).",
+	33:   "Exit  " + Prefix + "SetLogLevel(%s); senzingDoctor.getLogger().SetLogLevel failed; returned (%v).",
+	39:   "Exit  " + Prefix + "SetLogLevel(%s) returned (%v).",
+	40:   "Enter " + Prefix + "UnregisterObserver(%s).",
+	41:   "Exit  " + Prefix + "UnregisterObserver(%s); json.Marshal failed; returned (%v).",
+	42:   "Exit  " + Prefix + "UnregisterObserver(%s); senzingDoctor.observers.UnregisterObserver failed; returned (%v).",
+	49:   "Exit  " + Prefix + "UnregisterObserver(%s) returned (%v).",
+	50:   "Enter " + Prefix + "SetObserverOrigin(%s).",
+	51:   "Exit  " + Prefix + "SetObserverOrigin(%s); json.Marshal failed; returned (%v).",
+	59:   "Exit  " + Prefix + "SetObserverOrigin(%s).",
+	1001: Prefix + "Diagnose parameters: %+v",
+	1002: Prefix + "RegisterObserver parameters: %+v",
+	1003: Prefix + "SetLogLevel parameters: %+v",
+	1004: Prefix + "SetObserverOrigin parameters: %+v",
+	1005: Prefix + "UnregisterObserver parameters: %+v",
+	1011: Prefix + "Diagnose(); json.Marshal failed; returned (%v).",
+	1021: Prefix + "RegisterObserver(%s); json.Marshal failed; returned (%v).",
+	1022: Prefix + "RegisterObserver(%s); senzingDoctor.observers.RegisterObserver failed; returned (%v).",
+	1031: Prefix + "SetLogLevel(%s); json.Marshal failed; returned (%v).",
+	1032: Prefix + "SetLogLevel(%s); logging.IsValidLogLevelName failed; returned (%v).",
+	1033: Prefix + "SetLogLevel(%s); senzingDoctor.getLogger().SetLogLevel failed; returned (%v).",
+	1041: Prefix + "UnregisterObserver(%s); json.Marshal failed; returned (%v).",
+	1042: Prefix + "UnregisterObserver(%s); senzingDoctor.observers.UnregisterObserver failed; returned (%v).",
+	1051: Prefix + "SetObserverOrigin(%s); json.Marshal failed; returned (%v).",
+	1100: Prefix + "Check %s [%s]: %s; %s",
+	8001: Prefix + "Diagnose",
+	8002: Prefix + "RegisterObserver",
+	8003: Prefix + "SetLogLevel",
+	8004: Prefix + "SetObserverOrigin",
+	8005: Prefix + "UnregisterObserver",
+}
+
+// Status strings for specific messages.
+var IDStatuses = map[int]string{}
+
+var errForPackage = errors.New("senzingdoctor")
@@ -0,0 +1,1025 @@
+package senzingdoctor
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/senzing-garage/go-databasing/connector"
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/go-helpers/settingsparser"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/go-sdk-abstract-factory/szfactorycreator"
+	"github.com/senzing-garage/init-database/senzingschema"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// BasicSenzingDoctor is the default implementation of the SenzingDoctor interface.
+type BasicSenzingDoctor struct {
+	DatabaseURLs          []string `json:"databaseUrls,omitempty"`
+	DatabaseURLsError     error    `json:"-"` // Why DatabaseURLs could not be determined; reported by the "database" check.
+	ObserverURL           string   `json:"observerUrl,omitempty"`
+	SenzingConfigJSONFile string   `json:"senzingConfigJsonFile,omitempty"`
+	SenzingInstanceName   string   `json:"senzingInstanceName,omitempty"`
+	SenzingSettings       string   `json:"senzingSettings,omitempty"`
+	SenzingVerboseLogging int64    `json:"senzingVerboseLogging,omitempty"`
+	SQLFile               string   `json:"sqlFile,omitempty"`
+
+	logger         logging.Logging
+	logLevelName   string
+	observerOrigin string
+	observers      subject.Subject
+}
+
+const (
+	checkConfigurationTemplate = "configuration-template"
+	checkDatabase              = "database"
+	checkDatabasePrivileges    = "database-privileges"
+	checkLicense               = "license"
+	checkObserver              = "observer"
+	checkResourcePath          = "resource-path"
+	checkSenzingLibrary        = "senzing-library"
+	checkSettings              = "settings"
+	checkSQLFile               = "sql-file"
+	defaultGrpcObserverPort    = "8260"
+	licenseWarningDays         = 30
+	timeoutInSeconds           = 10
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var debugOptions = []interface{}{
+	&logging.OptionCallerSkip{Value: OptionCallerSkip5},
+}
+
+var traceOptions = []interface{}{
+	&logging.OptionCallerSkip{Value: OptionCallerSkip5},
+}
+
+// SQL used to ask each database engine for its version.
+var databaseVersionQueries = map[string]string{
+	"mssql":      "SELECT @@VERSION",
+	"mysql":      "SELECT VERSION()",
+	"oci":        "SELECT BANNER FROM V$VERSION WHERE ROWNUM = 1",
+	"postgresql": "SELECT version()",
+	"sqlite3":    "SELECT sqlite_version()",
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The Diagnose method checks, one by one, everything init-database depends upon.
+A failing check does not stop the checks that follow it.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The result of each check, in the order the checks were made.
+*/
+func (senzingDoctor *BasicSenzingDoctor) Diagnose(ctx context.Context) ([]CheckResult, error) {
+	var (
+		err    error
+		result []CheckResult
+	)
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 19
+
+	if senzingDoctor.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingDoctor.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingDoctor.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingDoctor.traceEntry(10)
+
+			defer func() { senzingDoctor.traceExit(traceExitMessageNumber, err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingDoctor)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 11, 1011
+
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingDoctor)
+		}
+
+		senzingDoctor.log(1001, senzingDoctor, string(asJSON))
+	}
+
+	// Settings must be parsable for most other checks to be meaningful.
+
+	settingsResult, parser := senzingDoctor.checkSettings(ctx)
+	result = append(result, settingsResult)
+
+	resourcePathResult, resourcePath := senzingDoctor.checkResourcePath(ctx, parser)
+	result = append(result, resourcePathResult)
+	result = append(result, senzingDoctor.checkSQLFiles(resourcePath)...)
+	result = append(result, senzingDoctor.checkConfigurationTemplate(resourcePath))
+
+	// Each database.
+
+	switch {
+	case senzingDoctor.DatabaseURLsError != nil:
+		result = append(result, CheckResult{
+			Check:  checkDatabase,
+			Detail: "database URLs could not be determined: " + senzingDoctor.DatabaseURLsError.Error(),
+			Status: StatusFail,
+		})
+	case len(senzingDoctor.DatabaseURLs) == 0:
+		result = append(result, CheckResult{
+			Check:  checkDatabase,
+			Detail: "no database URLs were specified or found in Senzing settings",
+			Status: StatusFail,
+		})
+	}
+
+	for _, databaseURL := range senzingDoctor.DatabaseURLs {
+		databaseResult := senzingDoctor.checkDatabase(ctx, databaseURL)
+		result = append(result, databaseResult)
+
+		if databaseResult.Status != StatusFail {
+			result = append(result, senzingDoctor.checkDatabasePrivileges(ctx, databaseURL))
+		}
+	}
+
+	// Senzing library, license, and observer.
+
+	result = append(result, senzingDoctor.checkSenzing(ctx, parser, settingsResult.Status == StatusPass)...)
+	result = append(result, senzingDoctor.checkObserver(ctx))
+
+	for _, checkResult := range result {
+		senzingDoctor.log(1100, checkResult.Check, checkResult.Target, checkResult.Status, checkResult.Detail)
+	}
+
+	// Notify observers.
+
+	if senzingDoctor.observers != nil {
+		go func() {
+			details := map[string]string{}
+			notifier.Notify(ctx, senzingDoctor.observers, senzingDoctor.observerOrigin, ComponentID, 8001, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The RegisterObserver method adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (senzingDoctor *BasicSenzingDoctor) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	if observer == nil {
+		return err
+	}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 29
+
+	if senzingDoctor.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingDoctor.debug(debugMessageNumber, observer.GetObserverID(ctx), err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingDoctor.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingDoctor.traceEntry(20, observer.GetObserverID(ctx))
+
+			defer func() {
+				senzingDoctor.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingDoctor)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 21, 1021
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingDoctor)
+		}
+
+		senzingDoctor.log(1002, senzingDoctor, string(asJSON))
+	}
+
+	// Create empty list of observers.
+
+	if senzingDoctor.observers == nil {
+		senzingDoctor.observers = &subject.SimpleSubject{}
+	}
+
+	// Register observer with senzingDoctor.
+
+	err = senzingDoctor.observers.RegisterObserver(ctx, observer)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 22, 1022
+
+		return wraperror.Errorf(err, "RegisterObserver")
+	}
+
+	// Notify observers.
+
+	go func() {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		notifier.Notify(ctx, senzingDoctor.observers, senzingDoctor.observerOrigin, ComponentID, 8002, err, details)
+	}()
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The SetLogLevel method sets the level of logging.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
+*/
+func (senzingDoctor *BasicSenzingDoctor) SetLogLevel(ctx context.Context, logLevelName string) error {
+	var err error
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 39
+
+	if senzingDoctor.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingDoctor.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingDoctor.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingDoctor.traceEntry(30, logLevelName)
+
+			defer func() {
+				senzingDoctor.traceExit(traceExitMessageNumber, logLevelName, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingDoctor)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 31, 1031
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingDoctor)
+		}
+
+		senzingDoctor.log(1003, senzingDoctor, string(asJSON))
+	}
+
+	// Verify value of logLevelName.
+
+	if !logging.IsValidLogLevelName(logLevelName) {
+		traceExitMessageNumber, debugMessageNumber = 32, 1032
+
+		return wraperror.Errorf(errForPackage, "invalid error level: %s", logLevelName)
+	}
+
+	// Set senzingDoctor log level.
+
+	senzingDoctor.logLevelName = logLevelName
+
+	err = senzingDoctor.getLogger().SetLogLevel(logLevelName)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 33, 1033
+
+		return wraperror.Errorf(err, "SetLogLevel: %s", logLevelName)
+	}
+
+	// Notify observers.
+
+	if senzingDoctor.observers != nil {
+		go func() {
+			details := map[string]string{
+				"logLevelName": logLevelName,
+			}
+			notifier.Notify(ctx, senzingDoctor.observers, senzingDoctor.observerOrigin, ComponentID, 8003, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The SetObserverOrigin method sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (senzingDoctor *BasicSenzingDoctor) SetObserverOrigin(ctx context.Context, origin string) {
+	var err error
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 59
+
+	if senzingDoctor.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingDoctor.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingDoctor.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingDoctor.traceEntry(50, origin)
+
+			defer func() {
+				senzingDoctor.traceExit(traceExitMessageNumber, origin, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingDoctor)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 51, 1051
+
+			return
+		}
+
+		senzingDoctor.log(1004, senzingDoctor, string(asJSON))
+	}
+
+	// Set origin.
+
+	senzingDoctor.observerOrigin = origin
+
+	// Notify observers.
+
+	if senzingDoctor.observers != nil {
+		go func() {
+			details := map[string]string{
+				"origin": origin,
+			}
+			notifier.Notify(ctx, senzingDoctor.observers, senzingDoctor.observerOrigin, ComponentID, 8004, err, details)
+		}()
+	}
+}
+
+/*
+The UnregisterObserver method removes the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (senzingDoctor *BasicSenzingDoctor) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	if observer == nil {
+		return err
+	}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 49
+
+	if senzingDoctor.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingDoctor.debug(debugMessageNumber, observer.GetObserverID(ctx), err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingDoctor.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingDoctor.traceEntry(40, observer.GetObserverID(ctx))
+
+			defer func() {
+				senzingDoctor.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingDoctor)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 41, 1041
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingDoctor)
+		}
+
+		senzingDoctor.log(1005, senzingDoctor, string(asJSON))
+	}
+
+	// Remove observer from this service.
+
+	if senzingDoctor.observers != nil {
+		// Tricky code:
+		// client.notify is called synchronously before client.observers is set to nil.
+		// In client.notify, each observer will get notified in a goroutine.
+		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		notifier.Notify(ctx, senzingDoctor.observers, senzingDoctor.observerOrigin, ComponentID, 8005, err, details)
+
+		err = senzingDoctor.observers.UnregisterObserver(ctx, observer)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 42, 1042
+
+			return wraperror.Errorf(err, "UnregisterObserver")
+		}
+
+		if !senzingDoctor.observers.HasObservers(ctx) {
+			senzingDoctor.observers = nil
+		}
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// --- Logging ----------------------------------------------------------------
+
+// Get the Logger singleton.
+func (senzingDoctor *BasicSenzingDoctor) getLogger() logging.Logging {
+	var err error
+
+	if senzingDoctor.logger == nil {
+		options := []interface{}{
+			&logging.OptionCallerSkip{Value: OptionCallerSkip4},
+			logging.OptionMessageFields{Value: []string{"id", "text"}},
+		}
+
+		senzingDoctor.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return senzingDoctor.logger
+}
+
+// Log message.
+func (senzingDoctor *BasicSenzingDoctor) log(messageNumber int, details ...interface{}) {
+	senzingDoctor.getLogger().Log(messageNumber, details...)
+}
+
+// Debug.
+func (senzingDoctor *BasicSenzingDoctor) debug(messageNumber int, details ...interface{}) {
+	details = append(details, debugOptions...)
+	senzingDoctor.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method entry.
+func (senzingDoctor *BasicSenzingDoctor) traceEntry(messageNumber int, details ...interface{}) {
+	details = append(details, traceOptions...)
+	senzingDoctor.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method exit.
+func (senzingDoctor *BasicSenzingDoctor) traceExit(messageNumber int, details ...interface{}) {
+	details = append(details, traceOptions...)
+	senzingDoctor.getLogger().Log(messageNumber, details...)
+}
+
+// --- Checks -----------------------------------------------------------------
+
+// Verify that the Senzing settings parse and refer to usable paths and databases.
+func (senzingDoctor *BasicSenzingDoctor) checkSettings(
+	ctx context.Context,
+) (CheckResult, settingsparser.SettingsParser) {
+	result := CheckResult{
+		Check:  checkSettings,
+		Status: StatusPass,
+	}
+
+	parser, err := settingsparser.New(senzingDoctor.SenzingSettings)
+	if err != nil {
+		result.Status, result.Detail = StatusFail, err.Error()
+
+		return result, nil
+	}
+
+	err = settings.VerifySettings(ctx, senzingDoctor.SenzingSettings)
+	if err != nil {
+		result.Status, result.Detail = StatusFail, err.Error()
+
+		return result, parser
+	}
+
+	result.Detail = summarizeSettings(ctx, parser)
+
+	return result, parser
+}
+
+// Verify that PIPELINE.RESOURCEPATH is a directory.
+func (senzingDoctor *BasicSenzingDoctor) checkResourcePath(
+	ctx context.Context,
+	parser settingsparser.SettingsParser,
+) (CheckResult, string) {
+	result := CheckResult{
+		Check:  checkResourcePath,
+		Status: StatusFail,
+	}
+
+	if parser == nil {
+		result.Status, result.Detail = StatusWarn, "not checked; settings could not be parsed"
+
+		return result, ""
+	}
+
+	resourcePath, err := parser.GetResourcePath(ctx)
+	if err != nil {
+		result.Detail = err.Error()
+
+		return result, ""
+	}
+
+	result.Target = resourcePath
+
+	fileInfo, err := os.Stat(resourcePath)
+
+	switch {
+	case err != nil:
+		result.Detail = err.Error()
+	case !fileInfo.IsDir():
+		result.Detail = "not a directory"
+	default:
+		result.Status = StatusPass
+	}
+
+	return result, resourcePath
+}
+
+// Verify that the file of SQL for each database scheme exists.
+func (senzingDoctor *BasicSenzingDoctor) checkSQLFiles(resourcePath string) []CheckResult {
+	result := []CheckResult{}
+	checkedFiles := map[string]bool{}
+
+	for _, databaseURL := range senzingDoctor.DatabaseURLs {
+		checkResult := CheckResult{
+			Check:  checkSQLFile,
+			Status: StatusPass,
+		}
+
+		sqlFile := senzingDoctor.SQLFile
+		if len(sqlFile) == 0 {
+			if len(resourcePath) == 0 {
+				checkResult.Status, checkResult.Detail = StatusWarn, "not checked; resource path unknown"
+				result = append(result, checkResult)
+
+				continue
+			}
+
+			parsedURL, err := url.Parse(databaseURL)
+			if err != nil {
+				checkResult.Status, checkResult.Detail = StatusFail, err.Error()
+				result = append(result, checkResult)
+
+				continue
+			}
+
+			sqlFile, err = senzingschema.GetSQLFile(resourcePath, parsedURL.Scheme)
+			if err != nil {
+				checkResult.Status, checkResult.Detail = StatusFail, err.Error()
+				result = append(result, checkResult)
+
+				continue
+			}
+		}
+
+		if checkedFiles[sqlFile] {
+			continue
+		}
+
+		checkedFiles[sqlFile] = true
+		checkResult.Target = sqlFile
+
+		_, err := os.Stat(sqlFile)
+		if err != nil {
+			checkResult.Status, checkResult.Detail = StatusFail, err.Error()
+		}
+
+		result = append(result, checkResult)
+	}
+
+	return result
+}
+
+// Verify that the Senzing configuration template is a JSON document having a G2_CONFIG section.
+func (senzingDoctor *BasicSenzingDoctor) checkConfigurationTemplate(resourcePath string) CheckResult {
+	result := CheckResult{
+		Check:  checkConfigurationTemplate,
+		Status: StatusFail,
+		Target: senzingDoctor.SenzingConfigJSONFile,
+	}
+
+	if len(result.Target) == 0 {
+		if len(resourcePath) == 0 {
+			result.Status, result.Detail = StatusWarn, "not checked; resource path unknown"
+
+			return result
+		}
+
+		result.Target = resourcePath + "/templates/g2config.json"
+	}
+
+	content, err := os.ReadFile(result.Target)
+	if err != nil {
+		result.Detail = err.Error()
+
+		return result
+	}
+
+	configuration := map[string]json.RawMessage{}
+
+	err = json.Unmarshal(content, &configuration)
+	if err != nil {
+		result.Detail = "not valid JSON: " + err.Error()
+
+		return result
+	}
+
+	if _, isOK := configuration["G2_CONFIG"]; !isOK {
+		result.Detail = "missing G2_CONFIG section"
+
+		return result
+	}
+
+	result.Status = StatusPass
+
+	return result
+}
+
+// Verify that a database is reachable and report its version.
+func (senzingDoctor *BasicSenzingDoctor) checkDatabase(ctx context.Context, databaseURL string) CheckResult {
+	result := CheckResult{
+		Check:  checkDatabase,
+		Status: StatusFail,
+		Target: senzingschema.RedactDatabaseURL(databaseURL),
+	}
+
+	parsedURL, err := url.Parse(databaseURL)
+	if err != nil {
+		result.Detail = err.Error()
+
+		return result
+	}
+
+	databaseConnector, err := connector.NewConnector(ctx, databaseURL)
+	if err != nil {
+		result.Detail = err.Error()
+
+		return result
+	}
+
+	database := sql.OpenDB(databaseConnector)
+
+	defer func() { _ = database.Close() }()
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeoutInSeconds*time.Second)
+	defer cancel()
+
+	err = database.PingContext(ctxTimeout)
+	if err != nil {
+		result.Detail = "unreachable: " + err.Error()
+
+		return result
+	}
+
+	// Reachable. Failing to get the version is only a warning.
+
+	var version string
+
+	err = database.QueryRowContext(ctxTimeout, databaseVersionQueries[parsedURL.Scheme]).Scan(&version)
+	if err != nil {
+		result.Status, result.Detail = StatusWarn, "reachable; version unknown: "+err.Error()
+
+		return result
+	}
+
+	result.Status, result.Detail = StatusPass, version
+
+	return result
+}
+
+// Verify that the database user has the privileges needed to create the Senzing schema.
+func (senzingDoctor *BasicSenzingDoctor) checkDatabasePrivileges(ctx context.Context, databaseURL string) CheckResult {
+	result := CheckResult{
+		Check:  checkDatabasePrivileges,
+		Status: StatusPass,
+		Target: senzingschema.RedactDatabaseURL(databaseURL),
+	}
+
+	senzingSchema := &senzingschema.BasicSenzingSchema{
+		DatabaseURLs:    []string{databaseURL},
+		SenzingSettings: senzingDoctor.SenzingSettings,
+	}
+
+	if len(senzingDoctor.logLevelName) > 0 {
+		err := senzingSchema.SetLogLevel(ctx, senzingDoctor.logLevelName)
+		if err != nil {
+			result.Status, result.Detail = StatusWarn, err.Error()
+
+			return result
+		}
+	}
+
+	err := senzingSchema.CheckPrivileges(ctx)
+	if err != nil {
+		result.Status, result.Detail = StatusFail, err.Error()
+	}
+
+	return result
+}
+
+// Verify that the Senzing native library loads and that the Senzing license is usable.
+func (senzingDoctor *BasicSenzingDoctor) checkSenzing(
+	ctx context.Context,
+	parser settingsparser.SettingsParser,
+	isSettingsOK bool,
+) []CheckResult {
+	libraryResult := CheckResult{
+		Check:  checkSenzingLibrary,
+		Status: StatusFail,
+	}
+	licenseResult := CheckResult{
+		Check:  checkLicense,
+		Status: StatusFail,
+	}
+
+	// The license string, if any, must be base64.
+
+	if parser != nil {
+		licenseStringBase64, err := parser.GetLicenseStringBase64(ctx)
+		if err == nil {
+			err = verifyLicenseStringBase64(licenseStringBase64)
+			if err != nil {
+				licenseResult.Detail = err.Error()
+			}
+		}
+	}
+
+	if !isSettingsOK {
+		libraryResult.Status, libraryResult.Detail = StatusWarn, "not checked; settings are not valid"
+		if len(licenseResult.Detail) == 0 {
+			licenseResult.Status, licenseResult.Detail = StatusWarn, "not checked; settings are not valid"
+		}
+
+		return []CheckResult{libraryResult, licenseResult}
+	}
+
+	// Load the Senzing library.
+
+	szProduct, closeFunc, err := senzingDoctor.createProduct(ctx)
+	if err != nil {
+		libraryResult.Detail = err.Error()
+		if len(licenseResult.Detail) == 0 {
+			licenseResult.Status, licenseResult.Detail = StatusWarn, "not checked; Senzing library did not load"
+		}
+
+		return []CheckResult{libraryResult, licenseResult}
+	}
+
+	defer closeFunc()
+
+	version, err := szProduct.GetVersion(ctx)
+	if err != nil {
+		libraryResult.Detail = err.Error()
+	} else {
+		libraryResult.Status, libraryResult.Detail = StatusPass, summarizeVersion(version)
+	}
+
+	if len(licenseResult.Detail) == 0 {
+		license, err := szProduct.GetLicense(ctx)
+		if err != nil {
+			licenseResult.Detail = err.Error()
+		} else {
+			licenseResult.Status, licenseResult.Detail = evaluateLicense(license, time.Now())
+		}
+	}
+
+	return []CheckResult{libraryResult, licenseResult}
+}
+
+// Verify that the Observer, if any, is listening.
+func (senzingDoctor *BasicSenzingDoctor) checkObserver(ctx context.Context) CheckResult {
+	result := CheckResult{
+		Check:  checkObserver,
+		Status: StatusFail,
+		Target: senzingDoctor.ObserverURL,
+	}
+
+	if len(senzingDoctor.ObserverURL) == 0 {
+		result.Status, result.Detail = StatusPass, "no observer URL specified"
+
+		return result
+	}
+
+	parsedURL, err := url.Parse(senzingDoctor.ObserverURL)
+	if err != nil {
+		result.Detail = err.Error()
+
+		return result
+	}
+
+	if parsedURL.Scheme != "grpc" {
+		result.Detail = "unsupported observer URL scheme: " + parsedURL.Scheme
+
+		return result
+	}
+
+	port := defaultGrpcObserverPort
+	if len(parsedURL.Port()) > 0 {
+		port = parsedURL.Port()
+	}
+
+	dialer := &net.Dialer{
+		Timeout: timeoutInSeconds * time.Second,
+	}
+
+	connection, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(parsedURL.Hostname(), port))
+	if err != nil {
+		result.Detail = "unreachable: " + err.Error()
+
+		return result
+	}
+
+	_ = connection.Close()
+
+	result.Status = StatusPass
+
+	return result
+}
+
+// --- Dependent services -----------------------------------------------------
+
+// Create an SzProduct and a function that releases it.
+func (senzingDoctor *BasicSenzingDoctor) createProduct(ctx context.Context) (senzing.SzProduct, func(), error) {
+	senzingInstanceName := senzingDoctor.SenzingInstanceName
+	if len(senzingInstanceName) == 0 {
+		senzingInstanceName = fmt.Sprintf("senzing init-database doctor at %s", time.Now())
+	}
+
+	szAbstractFactory, err := szfactorycreator.CreateCoreAbstractFactory(
+		senzingInstanceName,
+		senzingDoctor.SenzingSettings,
+		senzingDoctor.SenzingVerboseLogging,
+		senzing.SzInitializeWithDefaultConfiguration,
+	)
+	if err != nil {
+		return nil, nil, wraperror.Errorf(err, "CreateCoreAbstractFactory")
+	}
+
+	szProduct, err := szAbstractFactory.CreateProduct(ctx)
+	if err != nil {
+		_ = szAbstractFactory.Close(ctx)
+
+		return nil, nil, wraperror.Errorf(err, "CreateProduct")
+	}
+
+	closeFunc := func() {
+		_ = szProduct.Destroy(ctx)
+		_ = szAbstractFactory.Close(ctx)
+	}
+
+	return szProduct, closeFunc, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Given the JSON of SzProduct.GetLicense, return the status of the license as of now, and a description of it.
+// A license that expires within 30 days is a warning; an expired license is a failure.
+func evaluateLicense(license string, now time.Time) (string, string) {
+	licenseInfo := struct {
+		ExpireDate  string `json:"expireDate"`
+		LicenseType string `json:"licenseType"`
+		RecordLimit int64  `json:"recordLimit"`
+	}{}
+
+	err := json.Unmarshal([]byte(license), &licenseInfo)
+	if err != nil {
+		return StatusWarn, "license could not be parsed: " + err.Error()
+	}
+
+	detail := fmt.Sprintf(
+		"%s; expires %s; record limit %d",
+		licenseInfo.LicenseType,
+		licenseInfo.ExpireDate,
+		licenseInfo.RecordLimit,
+	)
+
+	expireDate, err := time.Parse(time.DateOnly, licenseInfo.ExpireDate)
+	if err != nil {
+		return StatusWarn, detail
+	}
+
+	switch {
+	case now.After(expireDate):
+		return StatusFail, "expired; " + detail
+	case now.AddDate(0, 0, licenseWarningDays).After(expireDate):
+		return StatusWarn, "expires soon; " + detail
+	default:
+		return StatusPass, detail
+	}
+}
+
+// Given the JSON of SzProduct.GetVersion, return a short description of it, or the JSON if it cannot be parsed.
+func summarizeVersion(version string) string {
+	versionInfo := struct {
+		BuildDate   string `json:"BUILD_DATE"`   //nolint:tagliatelle
+		ProductName string `json:"PRODUCT_NAME"` //nolint:tagliatelle
+		Version     string `json:"VERSION"`      //nolint:tagliatelle
+	}{}
+
+	err := json.Unmarshal([]byte(version), &versionInfo)
+	if err != nil {
+		return version
+	}
+
+	return fmt.Sprintf("%s %s (%s)", versionInfo.ProductName, versionInfo.Version, versionInfo.BuildDate)
+}
+
+// Verify that PIPELINE.LICENSESTRINGBASE64 of Senzing settings, if any, is base64.
+func verifyLicenseStringBase64(licenseStringBase64 string) error {
+	if len(licenseStringBase64) == 0 {
+		return nil
+	}
+
+	_, err := base64.StdEncoding.DecodeString(licenseStringBase64)
+	if err != nil {
+		return wraperror.Errorf(err, "LICENSESTRINGBASE64 is not base64")
+	}
+
+	return nil
+}
+
+// Given parsed Senzing settings, return a short description of them.
+func summarizeSettings(ctx context.Context, parser settingsparser.SettingsParser) string {
+	databaseURIs, err := parser.GetDatabaseURIs(ctx)
+	if err != nil {
+		return "valid"
+	}
+
+	return fmt.Sprintf("valid; %d database(s)", len(databaseURIs))
+}
@@ -0,0 +1,96 @@
+//go:build linux
+
+package senzingdoctor_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/init-database/senzingdoctor"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleBasicSenzingDoctor_Diagnose() {
+	// For more information, visit https://github.com/senzing-garage/init-database/blob/main/senzingdoctor/senzingdoctor_examples_test.go
+	ctx := context.TODO()
+
+	senzingSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	senzingDoctor := &senzingdoctor.BasicSenzingDoctor{
+		SenzingSettings: senzingSettings,
+	}
+
+	checkResults, err := senzingDoctor.Diagnose(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(checkResults[0].Check)
+	// Output: settings
+}
+
+func ExampleBasicSenzingDoctor_RegisterObserver() {
+	// For more information, visit https://github.com/senzing-garage/init-database/blob/main/senzingdoctor/senzingdoctor_examples_test.go
+	ctx := context.TODO()
+	anObserver := &observer.NullObserver{
+		ID:       observerID,
+		IsSilent: true,
+	}
+	senzingDoctor := &senzingdoctor.BasicSenzingDoctor{}
+
+	err := senzingDoctor.RegisterObserver(ctx, anObserver)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+}
+
+func ExampleBasicSenzingDoctor_SetLogLevel() {
+	// For more information, visit https://github.com/senzing-garage/init-database/blob/main/senzingdoctor/senzingdoctor_examples_test.go
+	ctx := context.TODO()
+	senzingDoctor := &senzingdoctor.BasicSenzingDoctor{}
+
+	err := senzingDoctor.SetLogLevel(ctx, logging.LevelInfoName)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+}
+
+func ExampleBasicSenzingDoctor_SetObserverOrigin() {
+	// For more information, visit https://github.com/senzing-garage/init-database/blob/main/senzingdoctor/senzingdoctor_examples_test.go
+	ctx := context.TODO()
+	senzingDoctor := &senzingdoctor.BasicSenzingDoctor{}
+	senzingDoctor.SetObserverOrigin(ctx, "TestObserver")
+	// Output:
+}
+
+func ExampleBasicSenzingDoctor_UnregisterObserver() {
+	// For more information, visit https://github.com/senzing-garage/init-database/blob/main/senzingdoctor/senzingdoctor_examples_test.go
+	ctx := context.TODO()
+	anObserver := &observer.NullObserver{
+		ID:       observerID,
+		IsSilent: true,
+	}
+	senzingDoctor := &senzingdoctor.BasicSenzingDoctor{}
+
+	err := senzingDoctor.RegisterObserver(ctx, anObserver)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	err = senzingDoctor.UnregisterObserver(ctx, anObserver)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+}
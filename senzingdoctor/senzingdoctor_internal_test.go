@@ -0,0 +1,118 @@
+package senzingdoctor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test private functions
+// ----------------------------------------------------------------------------
+
+func TestEvaluateLicense(test *testing.T) {
+	now := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name           string
+		license        string
+		expectedStatus string
+		expectedDetail string
+	}{
+		{
+			name:           "valid",
+			license:        `{"expireDate":"2027-01-01","licenseType":"EVAL","recordLimit":100000}`,
+			expectedStatus: StatusPass,
+			expectedDetail: "EVAL; expires 2027-01-01; record limit 100000",
+		},
+		{
+			name:           "nearExpiry",
+			license:        `{"expireDate":"2026-03-15","licenseType":"EVAL","recordLimit":100000}`,
+			expectedStatus: StatusWarn,
+			expectedDetail: "expires soon; EVAL; expires 2026-03-15; record limit 100000",
+		},
+		{
+			name:           "expired",
+			license:        `{"expireDate":"2026-02-01","licenseType":"EVAL","recordLimit":100000}`,
+			expectedStatus: StatusFail,
+			expectedDetail: "expired; EVAL; expires 2026-02-01; record limit 100000",
+		},
+		{
+			name:           "unknownExpireDate",
+			license:        `{"expireDate":"someday","licenseType":"EVAL","recordLimit":100000}`,
+			expectedStatus: StatusWarn,
+			expectedDetail: "EVAL; expires someday; record limit 100000",
+		},
+		{
+			name:           "badJSON",
+			license:        "{not JSON",
+			expectedStatus: StatusWarn,
+			expectedDetail: "license could not be parsed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			status, detail := evaluateLicense(testCase.license, now)
+			require.Equal(test, testCase.expectedStatus, status)
+			require.Contains(test, detail, testCase.expectedDetail)
+		})
+	}
+}
+
+func TestSummarizeVersion(test *testing.T) {
+	testCases := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:     "valid",
+			version:  `{"PRODUCT_NAME":"Senzing SDK","VERSION":"4.0.0","BUILD_DATE":"2025-01-01"}`,
+			expected: "Senzing SDK 4.0.0 (2025-01-01)",
+		},
+		{
+			name:     "badJSON",
+			version:  "{not JSON",
+			expected: "{not JSON",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			require.Equal(test, testCase.expected, summarizeVersion(testCase.version))
+		})
+	}
+}
+
+func TestVerifyLicenseStringBase64(test *testing.T) {
+	testCases := []struct {
+		name                string
+		licenseStringBase64 string
+		isError             bool
+	}{
+		{
+			name:                "valid",
+			licenseStringBase64: "bGljZW5zZQ==",
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:                "invalidBase64",
+			licenseStringBase64: "not base64!",
+			isError:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			err := verifyLicenseStringBase64(testCase.licenseStringBase64)
+			if testCase.isError {
+				require.ErrorContains(test, err, "LICENSESTRINGBASE64 is not base64")
+			} else {
+				require.NoError(test, err)
+			}
+		})
+	}
+}
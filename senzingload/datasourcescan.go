@@ -0,0 +1,122 @@
+package senzingload
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The CountDataSources function counts the records for each distinct DATA_SOURCE in JSON lines.
+Blank lines are skipped.
+
+Input
+  - reader: The JSON lines.
+  - dataSourceCounts: The number of records for each datasource, added to by this function.
+
+Output
+  - The number of records read.
+*/
+func CountDataSources(reader io.Reader, dataSourceCounts map[string]int64) (int64, error) {
+	var (
+		jsonRecord  record
+		lineNumber  int64
+		recordCount int64
+	)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		jsonRecord = record{}
+
+		err := json.Unmarshal(line, &jsonRecord)
+		if err != nil {
+			return recordCount, wraperror.Errorf(err, "line %d: %s", lineNumber, string(line))
+		}
+
+		dataSource := strings.TrimSpace(jsonRecord.DataSource)
+		if len(dataSource) == 0 {
+			return recordCount, wraperror.Errorf(errForPackage, "line %d: no DATA_SOURCE: %s", lineNumber, string(line))
+		}
+
+		dataSourceCounts[dataSource]++
+		recordCount++
+	}
+
+	return recordCount, wraperror.Errorf(scanner.Err(), "Scanning")
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Count the records for each datasource in each URL of JSON lines.
+func (senzingLoad *BasicSenzingLoad) scanURLs(ctx context.Context) ([]DataSourceCount, error) {
+	result := []DataSourceCount{}
+	dataSourceCounts := map[string]int64{}
+
+	httpClient := &http.Client{
+		Timeout: timeoutInMinutes * time.Minute,
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeoutInMinutes*time.Minute)
+	defer cancel()
+
+	jsonURLs, err := ResolveJSONURLs(senzingLoad.JSONURLs)
+	if err != nil {
+		return result, wraperror.Errorf(err, "ResolveJSONURLs")
+	}
+
+	for _, jsonURL := range jsonURLs {
+		var (
+			jsonLines   io.ReadCloser
+			recordCount int64
+		)
+
+		jsonLines, err = openJSONURL(ctxTimeout, httpClient, jsonURL)
+		if err != nil {
+			return result, wraperror.Errorf(err, "openJSONURL")
+		}
+
+		recordCount, err = CountDataSources(jsonLines, dataSourceCounts)
+
+		errClose := jsonLines.Close()
+
+		if err != nil {
+			return result, wraperror.Errorf(err, "CountDataSources: %s", jsonURL)
+		}
+
+		if errClose != nil {
+			return result, wraperror.Errorf(errClose, "Close: %s", jsonURL)
+		}
+
+		senzingLoad.log(2004, recordCount, jsonURL)
+	}
+
+	for _, dataSource := range slices.Sorted(maps.Keys(dataSourceCounts)) {
+		result = append(result, DataSourceCount{
+			DataSource:  dataSource,
+			RecordCount: dataSourceCounts[dataSource],
+		})
+	}
+
+	return result, nil
+}
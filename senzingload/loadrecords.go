@@ -0,0 +1,227 @@
+package senzingload
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A line of JSON read by LoadJSONLines, waiting for a worker to add it.
+type loadLine struct {
+	jsonURL    string
+	line       []byte
+	lineNumber int64
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The LoadJSONLines function adds the records in URLs and files of JSON lines using a pool of workers.
+
+One goroutine reads the lines, in order, into a bounded channel.
+Each worker takes lines from the channel, extracts DATA_SOURCE and RECORD_ID, and calls addRecord.
+Blank lines are skipped.
+On the first error, or if ctx is canceled, reading stops and the lines not yet added are discarded.
+The errors of all workers are returned together,
+except those of records canceled because another worker failed.
+
+Input
+  - ctx: A context to control lifecycle.
+  - jsonURLs: The URLs and paths of files.  See ResolveJSONURLs.
+  - numberOfWorkers: The number of workers.  If not positive, GOMAXPROCS is used.
+  - addRecord: Adds a record.  It is called concurrently by the workers.
+
+Output
+  - The number of records added, in total and for each URL, and the throughput.
+*/
+func LoadJSONLines(
+	ctx context.Context,
+	jsonURLs []string,
+	numberOfWorkers int,
+	addRecord func(ctx context.Context, dataSource string, recordID string, jsonRecord string) error,
+) (LoadStatistics, error) {
+	entryTime := time.Now()
+
+	if numberOfWorkers <= 0 {
+		numberOfWorkers = runtime.GOMAXPROCS(0)
+	}
+
+	result := LoadStatistics{
+		NumberOfWorkers: numberOfWorkers,
+		RecordCounts:    map[string]int64{},
+	}
+
+	ctxLoad, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		errs               []error
+		isCanceledByWorker bool
+		mutex              sync.Mutex
+		waitGroup          sync.WaitGroup
+	)
+
+	loadLines := make(chan loadLine, numberOfWorkers*2) //nolint:mnd
+
+	for range numberOfWorkers {
+		waitGroup.Go(func() {
+			for aLoadLine := range loadLines {
+				// After cancellation, drain the channel so the reader is not blocked.
+
+				if ctxLoad.Err() != nil {
+					continue
+				}
+
+				err := addLoadLine(ctxLoad, aLoadLine, addRecord)
+
+				mutex.Lock()
+
+				switch {
+				case err == nil:
+					result.RecordCount++
+					result.RecordCounts[aLoadLine.jsonURL]++
+				case isCanceledByWorker && errors.Is(err, context.Canceled):
+					// The failure of another worker, already reported, canceled this record.
+				default:
+					errs = append(errs, err)
+					isCanceledByWorker = true
+
+					cancel()
+				}
+
+				mutex.Unlock()
+			}
+		})
+	}
+
+	errRead := readJSONLines(ctxLoad, jsonURLs, loadLines)
+
+	close(loadLines)
+	waitGroup.Wait()
+
+	// A read stopped because a worker failed is reported by the worker's error.
+
+	if errRead != nil && (len(errs) == 0 || ctxLoad.Err() == nil) {
+		errs = append(errs, errRead)
+	}
+
+	result.Duration = time.Since(entryTime)
+	if result.Duration > 0 {
+		result.RecordsPerSecond = float64(result.RecordCount) / result.Duration.Seconds()
+	}
+
+	return result, wraperror.Errorf(errors.Join(errs...), wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Extract DATA_SOURCE and RECORD_ID from a line of JSON and add the record.
+func addLoadLine(
+	ctx context.Context,
+	aLoadLine loadLine,
+	addRecord func(ctx context.Context, dataSource string, recordID string, jsonRecord string) error,
+) error {
+	var jsonRecord record
+
+	err := json.Unmarshal(aLoadLine.line, &jsonRecord)
+	if err != nil {
+		return wraperror.Errorf(err, "%s line %d: %s", aLoadLine.jsonURL, aLoadLine.lineNumber, string(aLoadLine.line))
+	}
+
+	err = addRecord(ctx, jsonRecord.DataSource, jsonRecord.ID, string(aLoadLine.line))
+	if err != nil {
+		err = wraperror.Errorf(
+			err,
+			"%s line %d: AddRecord DataSource: %s; RecordID: %s",
+			aLoadLine.jsonURL,
+			aLoadLine.lineNumber,
+			jsonRecord.DataSource,
+			jsonRecord.ID,
+		)
+
+		// Keep a cancellation detectable by errors.Is.
+
+		if ctx.Err() != nil {
+			err = errors.Join(ctx.Err(), err)
+		}
+	}
+
+	return err
+}
+
+// Read the lines of each URL of JSON lines, in order, into a channel.
+func readJSONLines(ctx context.Context, jsonURLs []string, loadLines chan<- loadLine) error {
+	httpClient := &http.Client{
+		Timeout: timeoutInMinutes * time.Minute,
+	}
+
+	for _, jsonURL := range jsonURLs {
+		err := readJSONURL(ctx, httpClient, jsonURL, loadLines)
+		if err != nil {
+			return wraperror.Errorf(err, "readJSONURL: %s", jsonURL)
+		}
+	}
+
+	return nil
+}
+
+// Read the lines of a URL of JSON lines into a channel.
+func readJSONURL(ctx context.Context, httpClient *http.Client, jsonURL string, loadLines chan<- loadLine) error {
+	var lineNumber int64
+
+	jsonLines, err := openJSONURL(ctx, httpClient, jsonURL)
+	if err != nil {
+		return wraperror.Errorf(err, "openJSONURL")
+	}
+
+	scanner := bufio.NewScanner(jsonLines)
+	for scanner.Scan() {
+		lineNumber++
+
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		// The scanner reuses its buffer, so each line sent is a copy.
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case loadLines <- loadLine{jsonURL: jsonURL, line: slices.Clone(line), lineNumber: lineNumber}:
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		err = scanner.Err()
+	}
+
+	errClose := jsonLines.Close()
+
+	if err != nil {
+		return wraperror.Errorf(err, "Scanning")
+	}
+
+	return wraperror.Errorf(errClose, "Close")
+}
@@ -0,0 +1,236 @@
+package senzingload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ResolveJSONURLs function expands sources of JSON lines into the URLs and files to be read, in order.
+
+A source is one of:
+  - an "http://", "https://", or EmbeddedURLPrefix URL, which is kept as is
+  - a "file://" URL or a path of a file
+  - a directory, which is replaced by the files in it, sorted by name, skipping hidden files and subdirectories
+  - a glob pattern, as in filepath.Match, which is replaced by the files and directories it matches, sorted by name,
+    skipping hidden ones unless the pattern starts with "."
+
+Input
+  - jsonURLs: The sources.
+
+Output
+  - The "http://", "https://", and EmbeddedURLPrefix URLs and paths of files.
+*/
+func ResolveJSONURLs(jsonURLs []string) ([]string, error) {
+	result := []string{}
+
+	for _, jsonURL := range jsonURLs {
+		if isHTTPURL(jsonURL) || isEmbeddedURL(jsonURL) {
+			result = append(result, jsonURL)
+
+			continue
+		}
+
+		path, err := getFilePath(jsonURL)
+		if err != nil {
+			return result, wraperror.Errorf(err, "getFilePath: %s", jsonURL)
+		}
+
+		paths := []string{path}
+
+		if strings.ContainsAny(path, "*?[") {
+			paths, err = filepath.Glob(path)
+			if err != nil {
+				return result, wraperror.Errorf(err, "filepath.Glob: %s", path)
+			}
+
+			if !isHiddenFile(path) {
+				paths = slices.DeleteFunc(paths, isHiddenFile)
+			}
+
+			if len(paths) == 0 {
+				return result, wraperror.Errorf(errForPackage, "no files match: %s", path)
+			}
+		}
+
+		for _, matchedPath := range paths {
+			var files []string
+
+			files, err = getFiles(matchedPath)
+			if err != nil {
+				return result, wraperror.Errorf(err, "getFiles: %s", matchedPath)
+			}
+
+			result = append(result, files...)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+The ValidateLoadFiles function verifies that sources of JSON lines are paths, not URLs.
+See ResolveJSONURLs.
+
+Input
+  - loadFiles: Paths of files, directories, or glob patterns.
+*/
+func ValidateLoadFiles(loadFiles []string) error {
+	for _, loadFile := range loadFiles {
+		if isHTTPURL(loadFile) || isEmbeddedURL(loadFile) || isFileURL(loadFile) {
+			return wraperror.Errorf(errForPackage, "a URL, not a path: %s", loadFile)
+		}
+	}
+
+	return nil
+}
+
+/*
+The ValidateLoadURLs function verifies that sources of JSON lines are "http://", "https://", or "file://" URLs.
+See ResolveJSONURLs.
+
+Input
+  - loadURLs: URLs of JSON lines.
+*/
+func ValidateLoadURLs(loadURLs []string) error {
+	for _, loadURL := range loadURLs {
+		if !isHTTPURL(loadURL) && !isFileURL(loadURL) {
+			return wraperror.Errorf(errForPackage, "not an http://, https://, or file:// URL: %s", loadURL)
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Return the files at a path.  A directory is replaced by the files in it.
+func getFiles(path string) ([]string, error) {
+	result := []string{}
+
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return result, wraperror.Errorf(err, "os.Stat: %s", path)
+	}
+
+	if !fileInfo.IsDir() {
+		return append(result, path), nil
+	}
+
+	dirEntries, err := os.ReadDir(path)
+	if err != nil {
+		return result, wraperror.Errorf(err, "os.ReadDir: %s", path)
+	}
+
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || isHiddenFile(dirEntry.Name()) {
+			continue
+		}
+
+		result = append(result, filepath.Join(path, dirEntry.Name()))
+	}
+
+	if len(result) == 0 {
+		return result, wraperror.Errorf(errForPackage, "no files in directory: %s", path)
+	}
+
+	return result, nil
+}
+
+// Return the path of a "file://" URL or plain path.
+func getFilePath(jsonURL string) (string, error) {
+	if !isFileURL(jsonURL) {
+		return jsonURL, nil
+	}
+
+	parsedURL, err := url.Parse(jsonURL)
+	if err != nil {
+		return "", wraperror.Errorf(err, "url.Parse")
+	}
+
+	if len(parsedURL.Host) > 0 && parsedURL.Host != "localhost" {
+		return "", wraperror.Errorf(errForPackage, "file URL must not name a remote host: %s", parsedURL.Host)
+	}
+
+	return filepath.FromSlash(parsedURL.Path), nil
+}
+
+func isEmbeddedURL(jsonURL string) bool {
+	return strings.HasPrefix(jsonURL, EmbeddedURLPrefix)
+}
+
+func isFileURL(jsonURL string) bool {
+	return strings.HasPrefix(strings.ToLower(jsonURL), "file://")
+}
+
+func isHiddenFile(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), ".")
+}
+
+func isHTTPURL(jsonURL string) bool {
+	lowerJSONURL := strings.ToLower(jsonURL)
+
+	return strings.HasPrefix(lowerJSONURL, "http://") || strings.HasPrefix(lowerJSONURL, "https://")
+}
+
+// Open a URL or file of JSON lines.  The caller closes it.
+func openJSONURL(ctx context.Context, httpClient *http.Client, jsonURL string) (io.ReadCloser, error) {
+	if isEmbeddedURL(jsonURL) {
+		file, err := embeddedFiles.Open(strings.TrimPrefix(jsonURL, EmbeddedURLPrefix))
+		if err != nil {
+			return nil, wraperror.Errorf(err, "embeddedFiles.Open: %s", jsonURL)
+		}
+
+		return file, nil
+	}
+
+	if !isHTTPURL(jsonURL) {
+		file, err := os.Open(filepath.Clean(jsonURL))
+		if err != nil {
+			return nil, wraperror.Errorf(err, "os.Open: %s", jsonURL)
+		}
+
+		return file, nil
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, jsonURL, nil)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "http.NewRequestWithContext")
+	}
+
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "httpClient.Do")
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		errBodyClose := httpResponse.Body.Close()
+
+		return nil, wraperror.Errorf(
+			errForPackage,
+			fmt.Sprintf(
+				"Received non-OK HTTP status: %d; URL: %s; Error for httpResponse.Body.Close: %v",
+				httpResponse.StatusCode,
+				jsonURL,
+				errBodyClose,
+			),
+		)
+	}
+
+	return httpResponse.Body, nil
+}
@@ -3,6 +3,7 @@ package senzingload
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // ----------------------------------------------------------------------------
@@ -11,6 +12,22 @@ import (
 
 type SenzingLoad interface {
 	LoadURLs(ctx context.Context) error
+	ScanDataSources(ctx context.Context) ([]DataSourceCount, error)
+}
+
+// DataSourceCount is the number of records found for a datasource by ScanDataSources.
+type DataSourceCount struct {
+	DataSource  string `json:"dataSource"`
+	RecordCount int64  `json:"recordCount"`
+}
+
+// LoadStatistics describes the records added by LoadJSONLines.
+type LoadStatistics struct {
+	Duration         time.Duration    `json:"duration"`
+	NumberOfWorkers  int              `json:"numberOfWorkers"`
+	RecordCount      int64            `json:"recordCount"`
+	RecordCounts     map[string]int64 `json:"recordCounts"`
+	RecordsPerSecond float64          `json:"recordsPerSecond"`
 }
 
 // ----------------------------------------------------------------------------
@@ -60,19 +77,31 @@ var IDMessages = map[int]string{
 	60:   "Enter " + Prefix + "SetObserverOrigin(%s).",
 	61:   "Exit  " + Prefix + "SetObserverOrigin(%s); json.Marshal failed; returned (%v).",
 	69:   "Exit  " + Prefix + "SetObserverOrigin(%s).",
+	70:   "Enter " + Prefix + "ScanDataSources().",
+	71:   "Exit  " + Prefix + "ScanDataSources(); json.Marshal failed; returned (%v).",
+	72:   "Exit  " + Prefix + "ScanDataSources(); senzingLoad.scanURLs failed; returned (%v).",
+	79:   "Exit  " + Prefix + "ScanDataSources() returned (%v).",
 	1001: Prefix + "LoadURLs parameters: %+v",
 	1002: Prefix + "RegisterObserver parameters: %+v",
 	1003: Prefix + "SetLogLevel parameters: %+v",
 	1004: Prefix + "SetObserverOrigin parameters: %+v",
 	1005: Prefix + "UnregisterObserver parameters: %+v",
+	1006: Prefix + "ScanDataSources parameters: %+v",
+	1071: Prefix + "ScanDataSources(); json.Marshal failed; Error: %v.",
+	1072: Prefix + "ScanDataSources(); senzingLoad.scanURLs failed; Error: %v.",
 	2002: "Processed %d records for URL: %s",
 	2003: "Processed %d redo records",
+	2004: "Scanned %d records for URL: %s",
+	2005: "Found %d records with datasource: %s",
+	2006: "Found %d datasources in %d records",
+	2007: "Loaded %d records in %s (%.1f records/second) using %d workers",
 	3001: "Processing URL: %s",
 	8001: Prefix + "LoadURLs",
 	8003: Prefix + "RegisterObserver",
 	8004: Prefix + "SetLogLevel",
 	8005: Prefix + "SetObserverOrigin",
 	8006: Prefix + "UnregisterObserver",
+	8007: Prefix + "ScanDataSources",
 }
 
 // Status strings for specific messages.
@@ -1,12 +1,10 @@
 package senzingload
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
 	"sync"
 	"time"
 
@@ -29,6 +27,7 @@ type BasicSenzingLoad struct {
 	JSONURLs              []string          `json:"jsonUrls,omitempty"`
 	GrpcDialOptions       []grpc.DialOption `json:"grpcDialOptions,omitempty"`
 	GrpcTarget            string            `json:"grpcTarget,omitempty"`
+	NumberOfWorkers       int               `json:"numberOfWorkers,omitempty"`
 	SenzingInstanceName   string            `json:"senzingInstanceName,omitempty"`
 	SenzingSettings       string            `json:"senzingSettings,omitempty"`
 	SenzingVerboseLogging int64             `json:"senzingVerboseLogging,omitempty"`
@@ -67,7 +66,8 @@ var traceOptions = []interface{}{
 // ----------------------------------------------------------------------------
 
 /*
-The LoadURLs method loads records from URLs of JSON lines into Senzing.
+The LoadURLs method loads records from URLs and files of JSON lines into Senzing.
+See ResolveJSONURLs for the sources accepted.
 It also process redo records before returning.
 
 Input
@@ -205,6 +205,90 @@ func (senzingLoad *BasicSenzingLoad) RegisterObserver(ctx context.Context, obser
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+The ScanDataSources method reads the records from URLs and files of JSON lines without loading them
+and counts the records for each distinct DATA_SOURCE.
+It is used to register the datasources before loading.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The number of records for each datasource, sorted by datasource.
+*/
+func (senzingLoad *BasicSenzingLoad) ScanDataSources(ctx context.Context) ([]DataSourceCount, error) {
+	var (
+		err    error
+		result []DataSourceCount
+	)
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 79
+
+	if senzingLoad.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingLoad.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingLoad.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingLoad.traceEntry(70)
+
+			defer func() { senzingLoad.traceExit(traceExitMessageNumber, err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingLoad)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 71, 1071
+
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingLoad)
+		}
+
+		senzingLoad.log(1006, senzingLoad, string(asJSON))
+	}
+
+	// Count records for each datasource in each URL of JSON lines.
+
+	result, err = senzingLoad.scanURLs(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 72, 1072
+
+		return result, wraperror.Errorf(err, "senzingLoad.scanURLs")
+	}
+
+	// Summarize.
+
+	var recordCount int64
+
+	for _, dataSourceCount := range result {
+		recordCount += dataSourceCount.RecordCount
+		senzingLoad.log(2005, dataSourceCount.RecordCount, dataSourceCount.DataSource)
+	}
+
+	senzingLoad.log(2006, len(result), recordCount)
+
+	// Notify observers.
+
+	if senzingLoad.observers != nil {
+		go func() {
+			details := map[string]string{}
+			notifier.Notify(ctx, senzingLoad.observers, senzingLoad.observerOrigin, ComponentID, 8007, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 /*
 The SetLogLevel method sets the level of logging.
 
@@ -506,14 +590,9 @@ func (senzingLoad *BasicSenzingLoad) processRecords(
 	ctx context.Context,
 	szAbstractFactory senzing.SzAbstractFactory,
 ) error {
-	var (
-		jsonRecord record
-		err        error
-	)
-
-	// Use timeout in ctx.
+	var err error
 
-	// Get an szEngine.
+	// Get an szEngine.  It is shared by the workers.
 
 	szEngine, err := szAbstractFactory.CreateEngine(ctx)
 	if err != nil {
@@ -522,108 +601,42 @@ func (senzingLoad *BasicSenzingLoad) processRecords(
 
 	defer func() { assertNoError(szEngine.Destroy(ctx), "Error on szEngine.Destroy()") }()
 
-	httpClient := &http.Client{
-		Timeout: timeoutInMinutes * time.Minute,
+	jsonURLs, err := ResolveJSONURLs(senzingLoad.JSONURLs)
+	if err != nil {
+		return wraperror.Errorf(err, "ResolveJSONURLs")
 	}
 
-	ctxTimeout, cancel := context.WithTimeout(ctx, timeoutInMinutes*time.Minute)
-	defer cancel() // Ensure the context is canceled when main exits
-
-	for _, jsonURL := range senzingLoad.JSONURLs {
-		select {
-		case <-ctxTimeout.Done():
-			return wraperror.Errorf(ctxTimeout.Err(), "HTTP Timeout")
-		default:
-			senzingLoad.log(3001, jsonURL)
-
-			// Download file from URL.
-
-			httpRequest, errNewRequestWithContext := http.NewRequestWithContext(
-				ctxTimeout,
-				http.MethodGet,
-				jsonURL,
-				nil,
-			)
-			if errNewRequestWithContext != nil {
-				return wraperror.Errorf(errNewRequestWithContext, "http.NewRequestWithContext")
-			}
-
-			httpResponse, errDo := httpClient.Do(httpRequest)
-			if errDo != nil {
-				return wraperror.Errorf(errDo, "httpClient.Do")
-			}
-
-			if httpResponse.StatusCode != http.StatusOK {
-				errBodyClose := httpResponse.Body.Close()
-
-				return wraperror.Errorf(
-					errForPackage,
-					fmt.Sprintf(
-						"Received non-OK HTTP status: %d; URL: %s; Error for httpResponse.Body.Close: %v",
-						httpResponse.StatusCode,
-						jsonURL,
-						errBodyClose,
-					),
-				)
-			}
-
-			// Process HTTP response body in-memory. That is, do not write to file.
-
-			jsonLineCount := 0
-
-			errScannerMessage := ""
-
-			var errScanner error
-
-			scanner := bufio.NewScanner(httpResponse.Body)
-			for scanner.Scan() {
-				jsonLineCount++
-				line := scanner.Bytes()
-
-				// Tricky code: The following code assumes that the JSON contains "DATA_SOURCE" and "RECORD_ID" JSON keys.
-
-				errScanner = json.Unmarshal(line, &jsonRecord)
-				if errScanner != nil {
-					errScannerMessage = "Scanning:  " + string(line)
-
-					break
-				}
-
-				_, errScanner = szEngine.AddRecord(
-					ctxTimeout,
-					jsonRecord.DataSource,
-					jsonRecord.ID,
-					string(line),
-					senzing.SzNoFlags,
-				)
-				if errScanner != nil {
-					errScannerMessage = fmt.Sprintf(
-						"szEngine.AddRecord DataSource: %s; RecordID: %s",
-						jsonRecord.DataSource,
-						jsonRecord.ID,
-					)
-
-					break
-				}
-			}
+	for _, jsonURL := range jsonURLs {
+		senzingLoad.log(3001, jsonURL)
+	}
 
-			if err = httpResponse.Body.Close(); err != nil {
-				return wraperror.Errorf(err, "Error for httpResponse.Body.Close")
-			}
+	// Add records using a pool of workers.
+	// A load may take longer than the HTTP client timeout of each URL, so the load itself has no timeout.
 
-			if errScanner != nil {
-				return wraperror.Errorf(errScanner, errScannerMessage)
-			}
+	loadStatistics, err := LoadJSONLines(
+		ctx,
+		jsonURLs,
+		senzingLoad.NumberOfWorkers,
+		func(ctx context.Context, dataSource string, recordID string, jsonRecord string) error {
+			_, errAddRecord := szEngine.AddRecord(ctx, dataSource, recordID, jsonRecord, senzing.SzNoFlags)
 
-			if err = scanner.Err(); err != nil {
-				return wraperror.Errorf(err, "Scanning")
-			}
+			return wraperror.Errorf(errAddRecord, "szEngine.AddRecord")
+		},
+	)
 
-			senzingLoad.log(2002, jsonLineCount, jsonURL)
-		}
+	for _, jsonURL := range jsonURLs {
+		senzingLoad.log(2002, loadStatistics.RecordCounts[jsonURL], jsonURL)
 	}
 
-	return wraperror.Errorf(err, wraperror.NoMessage)
+	senzingLoad.log(
+		2007,
+		loadStatistics.RecordCount,
+		loadStatistics.Duration.Round(time.Millisecond),
+		loadStatistics.RecordsPerSecond,
+		loadStatistics.NumberOfWorkers,
+	)
+
+	return wraperror.Errorf(err, "LoadJSONLines")
 }
 
 func (senzingLoad *BasicSenzingLoad) processRedoRecords(
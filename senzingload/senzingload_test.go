@@ -2,8 +2,15 @@ package senzingload_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/senzing-garage/go-helpers/env"
 	"github.com/senzing-garage/go-helpers/settings"
@@ -48,6 +55,14 @@ func TestSenzingLoadBasic_LoadURLs(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestSenzingLoadBasic_LoadURLs_embedded(test *testing.T) {
+	ctx := test.Context()
+	senzingLoad := getTestObject(ctx, test)
+	senzingLoad.JSONURLs = senzingload.EmbeddedTruthsetURLs
+	err := senzingLoad.LoadURLs(ctx)
+	require.NoError(test, err)
+}
+
 func TestSenzingLoadBasic_RegisterObserver(test *testing.T) {
 	ctx := test.Context()
 	senzingConfig := getTestObject(ctx, test)
@@ -59,6 +74,19 @@ func TestSenzingLoadBasic_RegisterObserver(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestSenzingLoadBasic_ScanDataSources(test *testing.T) {
+	ctx := test.Context()
+	senzingLoad := getTestObject(ctx, test)
+	senzingLoad.JSONURLs = senzingload.EmbeddedTruthsetURLs
+	dataSourceCounts, err := senzingLoad.ScanDataSources(ctx)
+	require.NoError(test, err)
+	require.Equal(test, []senzingload.DataSourceCount{
+		{DataSource: "CUSTOMERS", RecordCount: 120},
+		{DataSource: "REFERENCE", RecordCount: 22},
+		{DataSource: "WATCHLIST", RecordCount: 17},
+	}, dataSourceCounts)
+}
+
 func TestSenzingLoadBasic_SetLogLevel(test *testing.T) {
 	ctx := test.Context()
 	senzingConfig := getTestObject(ctx, test)
@@ -85,6 +113,203 @@ func TestSenzingLoadBasic_UnregisterObserver(test *testing.T) {
 	require.NoError(test, err)
 }
 
+// ----------------------------------------------------------------------------
+// Test public functions
+// ----------------------------------------------------------------------------
+
+func TestCountDataSources(test *testing.T) {
+	jsonLines := `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}
+{"DATA_SOURCE": "WATCHLIST", "RECORD_ID": "2001"}
+
+{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1002"}
+`
+	dataSourceCounts := map[string]int64{"CUSTOMERS": 1}
+	recordCount, err := senzingload.CountDataSources(strings.NewReader(jsonLines), dataSourceCounts)
+	require.NoError(test, err)
+	require.Equal(test, int64(3), recordCount)
+	require.Equal(test, map[string]int64{"CUSTOMERS": 3, "WATCHLIST": 1}, dataSourceCounts)
+}
+
+func TestCountDataSources_badJSON(test *testing.T) {
+	jsonLines := `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}
+{"DATA_SOURCE": "CUSTOMERS",
+`
+	recordCount, err := senzingload.CountDataSources(strings.NewReader(jsonLines), map[string]int64{})
+	require.ErrorContains(test, err, "line 2")
+	require.Equal(test, int64(1), recordCount)
+}
+
+func TestCountDataSources_noDataSource(test *testing.T) {
+	jsonLines := `{"RECORD_ID": "1001"}`
+	_, err := senzingload.CountDataSources(strings.NewReader(jsonLines), map[string]int64{})
+	require.ErrorContains(test, err, "line 1: no DATA_SOURCE")
+}
+
+func TestLoadJSONLines(test *testing.T) {
+	var addRecordCount atomic.Int64
+
+	jsonFile := filepath.Join(test.TempDir(), "records.jsonl")
+	jsonLines := "{\"DATA_SOURCE\": \"TEST\", \"RECORD_ID\": \"1\"}\n\n{\"DATA_SOURCE\": \"TEST\", \"RECORD_ID\": \"2\"}\n"
+	require.NoError(test, os.WriteFile(jsonFile, []byte(jsonLines), 0o600))
+
+	jsonURLs := append([]string{jsonFile}, senzingload.EmbeddedTruthsetURLs...)
+	loadStatistics, err := senzingload.LoadJSONLines(
+		test.Context(),
+		jsonURLs,
+		4,
+		func(_ context.Context, _ string, _ string, _ string) error {
+			addRecordCount.Add(1)
+
+			return nil
+		},
+	)
+	require.NoError(test, err)
+	require.Equal(test, int64(161), loadStatistics.RecordCount)
+	require.Equal(test, int64(161), addRecordCount.Load())
+	require.Equal(test, int64(2), loadStatistics.RecordCounts[jsonFile])
+	require.Equal(test, int64(120), loadStatistics.RecordCounts[senzingload.EmbeddedTruthsetURLs[0]])
+	require.Equal(test, 4, loadStatistics.NumberOfWorkers)
+}
+
+func TestLoadJSONLines_addRecordError(test *testing.T) {
+	loadStatistics, err := senzingload.LoadJSONLines(
+		test.Context(),
+		senzingload.EmbeddedTruthsetURLs,
+		0,
+		func(_ context.Context, _ string, recordID string, _ string) error {
+			if recordID == "1005" {
+				return errors.New("test error")
+			}
+
+			return nil
+		},
+	)
+	require.ErrorContains(test, err, "RecordID: 1005")
+	require.Less(test, loadStatistics.RecordCount, int64(159))
+	require.Equal(test, runtime.GOMAXPROCS(0), loadStatistics.NumberOfWorkers)
+}
+
+func TestLoadJSONLines_addRecordErrorCancelsOthers(test *testing.T) {
+	var startedCount atomic.Int64
+
+	jsonFile := filepath.Join(test.TempDir(), "records.jsonl")
+	jsonLines := ""
+
+	for recordID := 1; recordID <= 4; recordID++ {
+		jsonLines += fmt.Sprintf("{\"DATA_SOURCE\": \"TEST\", \"RECORD_ID\": \"%d\"}\n", recordID)
+	}
+
+	require.NoError(test, os.WriteFile(jsonFile, []byte(jsonLines), 0o600))
+
+	// Each of the 4 workers adds one record.  Record 1 fails once all have started; the others wait to be canceled.
+
+	_, err := senzingload.LoadJSONLines(
+		test.Context(),
+		[]string{jsonFile},
+		4,
+		func(ctx context.Context, _ string, recordID string, _ string) error {
+			startedCount.Add(1)
+
+			if recordID != "1" {
+				<-ctx.Done()
+
+				return ctx.Err()
+			}
+
+			for startedCount.Load() < 4 {
+				time.Sleep(time.Millisecond)
+			}
+
+			return errors.New("test error")
+		},
+	)
+	require.ErrorContains(test, err, "RecordID: 1")
+	require.NotErrorIs(test, err, context.Canceled)
+	require.NotContains(test, err.Error(), context.Canceled.Error())
+}
+
+func TestLoadJSONLines_canceled(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	cancel()
+
+	loadStatistics, err := senzingload.LoadJSONLines(
+		ctx,
+		senzingload.EmbeddedTruthsetURLs,
+		2,
+		func(_ context.Context, _ string, _ string, _ string) error { return nil },
+	)
+	require.ErrorContains(test, err, context.Canceled.Error())
+	require.Zero(test, loadStatistics.RecordCount)
+}
+
+func TestResolveJSONURLs(test *testing.T) {
+	directory := test.TempDir()
+	for _, name := range []string{"b.jsonl", "a.jsonl", ".hidden.jsonl", "c.txt"} {
+		require.NoError(test, os.WriteFile(filepath.Join(directory, name), []byte("{}\n"), 0o600))
+	}
+
+	require.NoError(test, os.Mkdir(filepath.Join(directory, "subdirectory"), 0o700))
+
+	jsonURLs, err := senzingload.ResolveJSONURLs([]string{
+		"https://example.com/records.jsonl",
+		directory,
+		filepath.Join(directory, "*.jsonl"),
+		"file://" + filepath.ToSlash(filepath.Join(directory, "c.txt")),
+	})
+	require.NoError(test, err)
+	require.Equal(test, []string{
+		"https://example.com/records.jsonl",
+		filepath.Join(directory, "a.jsonl"),
+		filepath.Join(directory, "b.jsonl"),
+		filepath.Join(directory, "c.txt"),
+		filepath.Join(directory, "a.jsonl"),
+		filepath.Join(directory, "b.jsonl"),
+		filepath.Join(directory, "c.txt"),
+	}, jsonURLs)
+}
+
+func TestResolveJSONURLs_embedded(test *testing.T) {
+	jsonURLs, err := senzingload.ResolveJSONURLs(senzingload.EmbeddedTruthsetURLs)
+	require.NoError(test, err)
+	require.Equal(test, senzingload.EmbeddedTruthsetURLs, jsonURLs)
+}
+
+func TestResolveJSONURLs_noMatch(test *testing.T) {
+	directory := test.TempDir()
+	_, err := senzingload.ResolveJSONURLs([]string{filepath.Join(directory, "*.jsonl")})
+	require.ErrorContains(test, err, "no files match")
+	_, err = senzingload.ResolveJSONURLs([]string{directory})
+	require.ErrorContains(test, err, "no files in directory")
+	_, err = senzingload.ResolveJSONURLs([]string{filepath.Join(directory, "missing.jsonl")})
+	require.Error(test, err)
+	_, err = senzingload.ResolveJSONURLs([]string{"file://remote.example.com/records.jsonl"})
+	require.ErrorContains(test, err, "remote host")
+}
+
+func TestValidateLoadFiles(test *testing.T) {
+	err := senzingload.ValidateLoadFiles([]string{"records.jsonl", "/data", "/data/*.jsonl"})
+	require.NoError(test, err)
+
+	for _, loadFile := range []string{"https://example.com/records.jsonl", "file:///data/records.jsonl"} {
+		err = senzingload.ValidateLoadFiles([]string{loadFile})
+		require.ErrorContains(test, err, "a URL, not a path", loadFile)
+	}
+}
+
+func TestValidateLoadURLs(test *testing.T) {
+	err := senzingload.ValidateLoadURLs([]string{
+		"http://example.com/records.jsonl",
+		"HTTPS://example.com/records.jsonl",
+		"file:///data/records.jsonl",
+	})
+	require.NoError(test, err)
+
+	for _, loadURL := range []string{"/data/records.jsonl", "/data/*.jsonl", senzingload.EmbeddedTruthsetURLs[0]} {
+		err = senzingload.ValidateLoadURLs([]string{loadURL})
+		require.ErrorContains(test, err, "not an http://, https://, or file:// URL", loadURL)
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helper functions
 // ----------------------------------------------------------------------------
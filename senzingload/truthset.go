@@ -0,0 +1,27 @@
+package senzingload
+
+import (
+	"embed"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// EmbeddedURLPrefix starts the URL of a file of JSON lines embedded in the binary.
+const EmbeddedURLPrefix = "embedded:///"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// EmbeddedTruthsetURLs locate the Senzing TruthSet JSON lines files embedded in the binary,
+// a copy of https://github.com/Senzing/truth-sets/tree/main/truthsets/demo.
+var EmbeddedTruthsetURLs = []string{
+	EmbeddedURLPrefix + "truthset/customers.jsonl",
+	EmbeddedURLPrefix + "truthset/reference.jsonl",
+	EmbeddedURLPrefix + "truthset/watchlist.jsonl",
+}
+
+//go:embed truthset/*.jsonl
+var embeddedFiles embed.FS
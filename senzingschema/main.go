@@ -12,6 +12,7 @@ import (
 // ----------------------------------------------------------------------------
 
 type SenzingSchema interface {
+	CheckPrivileges(ctx context.Context) error
 	InitializeSenzing(ctx context.Context) error
 	RegisterObserver(ctx context.Context, observer observer.Observer) error
 	SetLogLevel(ctx context.Context, logLevelName string) error
@@ -63,6 +64,11 @@ var IDMessages = map[int]string{
 	50:   "Enter " + Prefix + "SetObserverOrigin(%s).",
 	51:   "Exit  " + Prefix + "SetObserverOrigin(%s); json.Marshal failed; returned (%v).",
 	59:   "Exit  " + Prefix + "SetObserverOrigin(%s).",
+	60:   "Enter " + Prefix + "CheckPrivileges().",
+	61:   "Exit  " + Prefix + "CheckPrivileges(); json.Marshal failed; returned (%v).",
+	62:   "Exit  " + Prefix + "CheckPrivileges(); senzingSchema.checkDatabasePrivileges failed; returned (%v).",
+	63:   "Exit  " + Prefix + "CheckPrivileges(); missing database privileges; returned (%v).",
+	69:   "Exit  " + Prefix + "CheckPrivileges() returned (%v).",
 	100:  "Enter " + Prefix + "processDatabase(%s, %s).",
 	101:  "Exit  " + Prefix + "processDatabase(%s, %s); url.Parse failed; returned (%v).",
 	102:  "Exit  " + Prefix + "processDatabase(%s, %s); connector.NewConnector failed; returned (%v).",
@@ -75,6 +81,7 @@ var IDMessages = map[int]string{
 	1003: Prefix + "SetLogLevel parameters: %+v",
 	1004: Prefix + "SetObserverOrigin parameters: %+v",
 	1005: Prefix + "UnregisterObserver parameters: %+v",
+	1006: Prefix + "CheckPrivileges parameters: %+v",
 	1011: Prefix + "InitializeSenzing(); json.Marshal failed; returned (%v).",
 	1012: Prefix + "InitializeSenzing(); settingsparser.New failed; returned (%v).",
 	1013: Prefix + "InitializeSenzing(); parser.GetResourcePath failed; returned (%v).",
@@ -87,12 +94,19 @@ var IDMessages = map[int]string{
 	1033: Prefix + "senzingSchema.getLogger().SetLogLevel(%s) failed; returned (%v).",
 	1041: Prefix + "UnregisterObserver(%s); json.Marshal failed; returned (%v).",
 	1042: Prefix + "UnregisterObserver(%s); senzingSchema.observers.UnregisterObserver failed; returned (%v).",
+	1061: Prefix + "CheckPrivileges(); json.Marshal failed; returned (%v).",
+	1062: Prefix + "CheckPrivileges(); senzingSchema.checkDatabasePrivileges failed; returned (%v).",
+	1063: Prefix + "CheckPrivileges(); missing database privileges; returned (%v).",
 	2001: "Sent SQL in %s to database %s",
+	2002: "Verified privileges of database user in %s",
+	3001: "Database %s is missing privileges: %s",
+	3002: "Could not drop probe table %s in database %s. Error: %v",
 	8001: Prefix + "InitializeSenzing",
 	8002: Prefix + "RegisterObserver",
 	8003: Prefix + "SetLogLevel",
 	8004: Prefix + "SetObserverOrigin",
 	8005: Prefix + "UnregisterObserver",
+	8006: Prefix + "CheckPrivileges",
 }
 
 // Status strings for specific messages.
@@ -3,6 +3,7 @@ package senzingschema_test
 import (
 	"testing"
 
+	"github.com/senzing-garage/go-helpers/env"
 	"github.com/senzing-garage/go-helpers/settings"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
@@ -12,10 +13,43 @@ import (
 
 const observerID = "Observer 1"
 
+var databaseURL = env.GetEnv("SENZING_TOOLS_DATABASE_URL", "sqlite3://na:na@nowhere/tmp/sqlite/G2C.db")
+
 // ----------------------------------------------------------------------------
 // Test interface functions
 // ----------------------------------------------------------------------------
 
+func TestSenzingSchemaImpl_CheckPrivileges(test *testing.T) {
+	ctx := test.Context()
+	senzingSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	testObject := &senzingschema.BasicSenzingSchema{
+		DatabaseURLs:    []string{databaseURL},
+		SenzingSettings: senzingSettings,
+	}
+	err = testObject.SetLogLevel(ctx, logging.LevelInfoName)
+	require.NoError(test, err)
+	err = testObject.CheckPrivileges(ctx)
+	require.NoError(test, err)
+}
+
+func TestSenzingSchemaImpl_CheckPrivileges_badDatabase(test *testing.T) {
+	ctx := test.Context()
+	senzingSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	testObject := &senzingschema.BasicSenzingSchema{
+		DatabaseURLs:    []string{"sqlite3://na:na@nowhere/tmp/no-such-directory/G2C.db"},
+		SenzingSettings: senzingSettings,
+	}
+	err = testObject.SetLogLevel(ctx, logging.LevelInfoName)
+	require.NoError(test, err)
+	err = testObject.CheckPrivileges(ctx)
+	require.Error(test, err)
+	require.NotContains(test, err.Error(), "insufficient database privileges")
+}
+
 func TestSenzingSchemaImpl_InitializeSenzing(test *testing.T) {
 	ctx := test.Context()
 	senzingSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
@@ -0,0 +1,4 @@
+/*
+Package senzingstatus reports the initialization state of Senzing databases.
+*/
+package senzingstatus
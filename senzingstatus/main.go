@@ -0,0 +1,115 @@
+package senzingstatus
+
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type SenzingStatus interface {
+	CheckHealth(ctx context.Context) error
+	GetStatus(ctx context.Context) ([]DatabaseStatus, error)
+	RegisterObserver(ctx context.Context, observer observer.Observer) error
+	SetLogLevel(ctx context.Context, logLevelName string) error
+	SetObserverOrigin(ctx context.Context, origin string)
+	UnregisterObserver(ctx context.Context, observer observer.Observer) error
+}
+
+// DatabaseStatus is the initialization state of a single database.
+// DefaultConfigID and Datasources are only reported for the first (primary) database,
+// as that is where the Senzing configuration is kept.
+type DatabaseStatus struct {
+	DatabaseURL       string           `json:"databaseUrl"`
+	Datasources       []string         `json:"datasources"`
+	DefaultConfigID   int64            `json:"defaultConfigId"`
+	Errors            []string         `json:"errors,omitempty"`
+	IsPrimary         bool             `json:"isPrimary"`
+	IsReachable       bool             `json:"isReachable"`
+	IsSchemaComplete  bool             `json:"isSchemaComplete"`
+	IsSchemaInstalled bool             `json:"isSchemaInstalled"`
+	MissingTables     []string         `json:"missingTables,omitempty"`
+	RecordCounts      map[string]int64 `json:"recordCounts"`
+	RedoCount         int64            `json:"redoCount"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identifier of the  package found messages having the format "senzing-6506xxxx".
+const ComponentID = 6506
+
+// Log message prefix.
+const Prefix = "init-database.senzingstatus."
+
+const (
+	OptionCallerSkip4 = 4
+	OptionCallerSkip5 = 5
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Message templates for senzingstatus implementation.
+var IDMessages = map[int]string{
+	10:   "Enter " + Prefix + "GetStatus().",
+	11:   "Exit  " + Prefix + "GetStatus(); json.Marshal failed; returned (%v).",
+	19:   "Exit  " + Prefix + "GetStatus() returned (%v).",
+	20:   "Enter " + Prefix + "RegisterObserver(%s).",
+	21:   "Exit  " + Prefix + "RegisterObserver(%s); json.Marshal failed; returned (%v).",
+	22:   "Exit  " + Prefix + "RegisterObserver(%s); senzingStatus.observers.RegisterObserver failed; returned (%v).",
+	29:   "Exit  " + Prefix + "RegisterObserver(%s) returned (%v).",
+	30:   "Enter " + Prefix + "SetLogLevel(%s).",
+	31:   "Exit  " + Prefix + "SetLogLevel(%s); json.Marshal failed; returned (%v).",
+	32:   "Exit  " + Prefix + "SetLogLevel(%s); logging.IsValidLogLevelName failed; returned (%v).",
+	33:   "Exit  " + Prefix + "SetLogLevel(%s); senzingStatus.getLogger().SetLogLevel failed; returned (%v).",
+	39:   "Exit  " + Prefix + "SetLogLevel(%s) returned (%v).",
+	40:   "Enter " + Prefix + "UnregisterObserver(%s).",
+	41:   "Exit  " + Prefix + "UnregisterObserver(%s); json.Marshal failed; returned (%v).",
+	42:   "Exit  " + Prefix + "UnregisterObserver(%s); senzingStatus.observers.UnregisterObserver failed; returned (%v).",
+	49:   "Exit  " + Prefix + "UnregisterObserver(%s) returned (%v).",
+	50:   "Enter " + Prefix + "SetObserverOrigin(%s).",
+	51:   "Exit  " + Prefix + "SetObserverOrigin(%s); json.Marshal failed; returned (%v).",
+	59:   "Exit  " + Prefix + "SetObserverOrigin(%s).",
+	60:   "Enter " + Prefix + "CheckHealth().",
+	61:   "Exit  " + Prefix + "CheckHealth(); json.Marshal failed; returned (%v).",
+	62:   "Exit  " + Prefix + "CheckHealth(); senzingStatus.GetStatus failed; returned (%v).",
+	69:   "Exit  " + Prefix + "CheckHealth() returned (%v).",
+	1001: Prefix + "GetStatus parameters: %+v",
+	1002: Prefix + "RegisterObserver parameters: %+v",
+	1003: Prefix + "SetLogLevel parameters: %+v",
+	1004: Prefix + "SetObserverOrigin parameters: %+v",
+	1005: Prefix + "UnregisterObserver parameters: %+v",
+	1006: Prefix + "CheckHealth parameters: %+v",
+	1011: Prefix + "GetStatus(); json.Marshal failed; returned (%v).",
+	1021: Prefix + "RegisterObserver(%s); json.Marshal failed; returned (%v).",
+	1022: Prefix + "RegisterObserver(%s); senzingStatus.observers.RegisterObserver failed; returned (%v).",
+	1031: Prefix + "SetLogLevel(%s); json.Marshal failed; returned (%v).",
+	1032: Prefix + "SetLogLevel(%s); logging.IsValidLogLevelName failed; returned (%v).",
+	1033: Prefix + "SetLogLevel(%s); senzingStatus.getLogger().SetLogLevel failed; returned (%v).",
+	1041: Prefix + "UnregisterObserver(%s); json.Marshal failed; returned (%v).",
+	1042: Prefix + "UnregisterObserver(%s); senzingStatus.observers.UnregisterObserver failed; returned (%v).",
+	1051: Prefix + "SetObserverOrigin(%s); json.Marshal failed; returned (%v).",
+	1061: Prefix + "CheckHealth(); json.Marshal failed; returned (%v).",
+	1062: Prefix + "CheckHealth(); senzingStatus.GetStatus failed; returned (%v).",
+	1100: Prefix + "Status of %s: %+v",
+	2001: "Healthy. Checked %d database(s)",
+	3001: "Unhealthy: %s",
+	8001: Prefix + "GetStatus",
+	8002: Prefix + "RegisterObserver",
+	8003: Prefix + "SetLogLevel",
+	8004: Prefix + "SetObserverOrigin",
+	8005: Prefix + "UnregisterObserver",
+	8006: Prefix + "CheckHealth",
+}
+
+// Status strings for specific messages.
+var IDStatuses = map[int]string{}
+
+var errForPackage = errors.New("senzingstatus")
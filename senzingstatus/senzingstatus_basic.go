@@ -0,0 +1,977 @@
+package senzingstatus
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-databasing/connector"
+	"github.com/senzing-garage/go-helpers/settingsparser"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/go-sdk-abstract-factory/szfactorycreator"
+	"github.com/senzing-garage/init-database/senzingschema"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// BasicSenzingStatus is the default implementation of the SenzingStatus interface.
+type BasicSenzingStatus struct {
+	DatabaseURLs          []string `json:"databaseUrls,omitempty"`
+	DataSources           []string `json:"dataSources,omitempty"`
+	SelfTest              bool     `json:"selfTest,omitempty"`
+	SenzingInstanceName   string   `json:"senzingInstanceName,omitempty"`
+	SenzingSettings       string   `json:"senzingSettings,omitempty"`
+	SenzingVerboseLogging int64    `json:"senzingVerboseLogging,omitempty"`
+	SQLFile               string   `json:"sqlFile,omitempty"`
+
+	logger         logging.Logging
+	observerOrigin string
+	observers      subject.Subject
+}
+
+// dataSourceRegistry is the JSON returned by SzConfig.GetDataSourceRegistry.
+type dataSourceRegistry struct {
+	DataSources []struct {
+		DsrcCode string `json:"DSRC_CODE"` //nolint:tagliatelle
+		DsrcID   int64  `json:"DSRC_ID"`   //nolint:tagliatelle
+	} `json:"DATA_SOURCES"` //nolint:tagliatelle
+}
+
+const (
+	selfTestDataSource     = "TEST"
+	selfTestRecordIDPrefix = "INIT-DATABASE-HEALTHCHECK"
+	tableOfRecords         = "DSRC_RECORD"
+	tableOfRedo            = "SYS_EVAL_QUEUE"
+	timeoutInSeconds       = 10
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var debugOptions = []interface{}{
+	&logging.OptionCallerSkip{Value: OptionCallerSkip5},
+}
+
+var traceOptions = []interface{}{
+	&logging.OptionCallerSkip{Value: OptionCallerSkip5},
+}
+
+// Finds the table name in "CREATE TABLE" statements.
+var createTableRegexp = regexp.MustCompile(`(?i)^\s*CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([^\s(]+)`)
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The CheckHealth method verifies that Senzing databases are ready for use.
+Every database must have a complete Senzing schema, the primary database must have
+a default Senzing configuration, and each of DataSources must be registered.
+If SelfTest is true, a test record is also added to Senzing and then deleted.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (senzingStatus *BasicSenzingStatus) CheckHealth(ctx context.Context) error {
+	var err error
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 69
+
+	if senzingStatus.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingStatus.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingStatus.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingStatus.traceEntry(60)
+
+			defer func() { senzingStatus.traceExit(traceExitMessageNumber, err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingStatus)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 61, 1061
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingStatus)
+		}
+
+		senzingStatus.log(1006, senzingStatus, string(asJSON))
+	}
+
+	// Evaluate the status of the databases.
+
+	databaseStatuses, err := senzingStatus.GetStatus(ctx)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 62, 1062
+
+		return wraperror.Errorf(err, "GetStatus")
+	}
+
+	problems := EvaluateHealth(databaseStatuses, senzingStatus.DataSources)
+
+	// Only exercise Senzing if everything else is healthy.
+
+	if len(problems) == 0 && senzingStatus.SelfTest {
+		selfTestErr := senzingStatus.selfTest(ctx)
+		if selfTestErr != nil {
+			problems = append(problems, "self test failed: "+selfTestErr.Error())
+		}
+	}
+
+	if len(problems) > 0 {
+		senzingStatus.log(3001, strings.Join(problems, "; "))
+		err = wraperror.Errorf(errForPackage, "unhealthy: %s", strings.Join(problems, "; "))
+	} else {
+		senzingStatus.log(2001, len(databaseStatuses))
+	}
+
+	// Notify observers.
+
+	if senzingStatus.observers != nil {
+		go func() {
+			details := map[string]string{}
+			notifier.Notify(ctx, senzingStatus.observers, senzingStatus.observerOrigin, ComponentID, 8006, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The GetStatus method reports the initialization state of each database.
+Problems with an individual database are reported in its DatabaseStatus.Errors
+rather than returned as an error.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The status of each database, in the order of DatabaseURLs.
+*/
+func (senzingStatus *BasicSenzingStatus) GetStatus(ctx context.Context) ([]DatabaseStatus, error) {
+	var (
+		err    error
+		result []DatabaseStatus
+	)
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 19
+
+	if senzingStatus.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingStatus.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingStatus.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingStatus.traceEntry(10)
+
+			defer func() { senzingStatus.traceExit(traceExitMessageNumber, err, time.Since(entryTime)) }()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingStatus)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 11, 1011
+
+			return result, wraperror.Errorf(err, "json.Marshal: %v", senzingStatus)
+		}
+
+		senzingStatus.log(1001, senzingStatus, string(asJSON))
+	}
+
+	// Inspect each database.  The first database holds the Senzing configuration.
+
+	dataSourceCodes := map[int64]string{}
+	recordCountsByID := make([]map[int64]int64, len(senzingStatus.DatabaseURLs))
+
+	for index, databaseURL := range senzingStatus.DatabaseURLs {
+		databaseStatus, recordCounts := senzingStatus.getDatabaseStatus(ctx, databaseURL)
+		databaseStatus.IsPrimary = index == 0
+
+		if databaseStatus.IsPrimary && databaseStatus.IsSchemaComplete {
+			var configErr error
+
+			databaseStatus.DefaultConfigID, dataSourceCodes, configErr = senzingStatus.getConfiguration(ctx)
+			if configErr != nil {
+				databaseStatus.Errors = append(databaseStatus.Errors, configErr.Error())
+			}
+
+			for _, dataSourceCode := range dataSourceCodes {
+				databaseStatus.Datasources = append(databaseStatus.Datasources, dataSourceCode)
+			}
+
+			slices.Sort(databaseStatus.Datasources)
+		}
+
+		recordCountsByID[index] = recordCounts
+
+		result = append(result, databaseStatus)
+	}
+
+	// Now that datasource identifiers are known, name the record counts.
+
+	for index := range result {
+		for dataSourceID, count := range recordCountsByID[index] {
+			dataSourceCode, isOK := dataSourceCodes[dataSourceID]
+			if !isOK {
+				dataSourceCode = strconv.FormatInt(dataSourceID, 10)
+			}
+
+			result[index].RecordCounts[dataSourceCode] += count
+		}
+
+		senzingStatus.log(1100, result[index].DatabaseURL, result[index])
+	}
+
+	// Notify observers.
+
+	if senzingStatus.observers != nil {
+		go func() {
+			details := map[string]string{}
+			notifier.Notify(ctx, senzingStatus.observers, senzingStatus.observerOrigin, ComponentID, 8001, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The RegisterObserver method adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (senzingStatus *BasicSenzingStatus) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	if observer == nil {
+		return err
+	}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 29
+
+	if senzingStatus.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingStatus.debug(debugMessageNumber, observer.GetObserverID(ctx), err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingStatus.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingStatus.traceEntry(20, observer.GetObserverID(ctx))
+
+			defer func() {
+				senzingStatus.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingStatus)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 21, 1021
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingStatus)
+		}
+
+		senzingStatus.log(1002, senzingStatus, string(asJSON))
+	}
+
+	// Create empty list of observers.
+
+	if senzingStatus.observers == nil {
+		senzingStatus.observers = &subject.SimpleSubject{}
+	}
+
+	// Register observer with senzingStatus.
+
+	err = senzingStatus.observers.RegisterObserver(ctx, observer)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 22, 1022
+
+		return wraperror.Errorf(err, "RegisterObserver")
+	}
+
+	// Notify observers.
+
+	go func() {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		notifier.Notify(ctx, senzingStatus.observers, senzingStatus.observerOrigin, ComponentID, 8002, err, details)
+	}()
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The SetLogLevel method sets the level of logging.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
+*/
+func (senzingStatus *BasicSenzingStatus) SetLogLevel(ctx context.Context, logLevelName string) error {
+	var err error
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 39
+
+	if senzingStatus.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingStatus.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingStatus.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingStatus.traceEntry(30, logLevelName)
+
+			defer func() {
+				senzingStatus.traceExit(traceExitMessageNumber, logLevelName, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingStatus)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 31, 1031
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingStatus)
+		}
+
+		senzingStatus.log(1003, senzingStatus, string(asJSON))
+	}
+
+	// Verify value of logLevelName.
+
+	if !logging.IsValidLogLevelName(logLevelName) {
+		traceExitMessageNumber, debugMessageNumber = 32, 1032
+
+		return wraperror.Errorf(errForPackage, "invalid error level: %s", logLevelName)
+	}
+
+	// Set senzingStatus log level.
+
+	err = senzingStatus.getLogger().SetLogLevel(logLevelName)
+	if err != nil {
+		traceExitMessageNumber, debugMessageNumber = 33, 1033
+
+		return wraperror.Errorf(err, "SetLogLevel: %s", logLevelName)
+	}
+
+	// Notify observers.
+
+	if senzingStatus.observers != nil {
+		go func() {
+			details := map[string]string{
+				"logLevelName": logLevelName,
+			}
+			notifier.Notify(ctx, senzingStatus.observers, senzingStatus.observerOrigin, ComponentID, 8003, err, details)
+		}()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The SetObserverOrigin method sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (senzingStatus *BasicSenzingStatus) SetObserverOrigin(ctx context.Context, origin string) {
+	var err error
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 59
+
+	if senzingStatus.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingStatus.debug(debugMessageNumber, err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingStatus.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingStatus.traceEntry(50, origin)
+
+			defer func() {
+				senzingStatus.traceExit(traceExitMessageNumber, origin, err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingStatus)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 51, 1051
+
+			return
+		}
+
+		senzingStatus.log(1004, senzingStatus, string(asJSON))
+	}
+
+	// Set origin.
+
+	senzingStatus.observerOrigin = origin
+
+	// Notify observers.
+
+	if senzingStatus.observers != nil {
+		go func() {
+			details := map[string]string{
+				"origin": origin,
+			}
+			notifier.Notify(ctx, senzingStatus.observers, senzingStatus.observerOrigin, ComponentID, 8004, err, details)
+		}()
+	}
+}
+
+/*
+The UnregisterObserver method removes the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (senzingStatus *BasicSenzingStatus) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	if observer == nil {
+		return err
+	}
+
+	// Prolog.
+
+	debugMessageNumber := 0
+	traceExitMessageNumber := 49
+
+	if senzingStatus.getLogger().IsDebug() {
+		// If DEBUG, log error exit.
+		defer func() {
+			if debugMessageNumber > 0 {
+				senzingStatus.debug(debugMessageNumber, observer.GetObserverID(ctx), err)
+			}
+		}()
+
+		// If TRACE, Log on entry/exit.
+
+		if senzingStatus.getLogger().IsTrace() {
+			entryTime := time.Now()
+
+			senzingStatus.traceEntry(40, observer.GetObserverID(ctx))
+
+			defer func() {
+				senzingStatus.traceExit(traceExitMessageNumber, observer.GetObserverID(ctx), err, time.Since(entryTime))
+			}()
+		}
+
+		// If DEBUG, log input parameters. Must be done after establishing DEBUG and TRACE logging.
+
+		asJSON, err := json.Marshal(senzingStatus)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 41, 1041
+
+			return wraperror.Errorf(err, "json.Marshal: %v", senzingStatus)
+		}
+
+		senzingStatus.log(1005, senzingStatus, string(asJSON))
+	}
+
+	// Remove observer from this service.
+
+	if senzingStatus.observers != nil {
+		// Tricky code:
+		// client.notify is called synchronously before client.observers is set to nil.
+		// In client.notify, each observer will get notified in a goroutine.
+		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		notifier.Notify(ctx, senzingStatus.observers, senzingStatus.observerOrigin, ComponentID, 8005, err, details)
+
+		err = senzingStatus.observers.UnregisterObserver(ctx, observer)
+		if err != nil {
+			traceExitMessageNumber, debugMessageNumber = 42, 1042
+
+			return wraperror.Errorf(err, "UnregisterObserver")
+		}
+
+		if !senzingStatus.observers.HasObservers(ctx) {
+			senzingStatus.observers = nil
+		}
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The EvaluateHealth function lists the reasons the databases are not ready for use.
+Errors encountered while reading a database are reported before anything they may have hidden,
+such as a default configuration that could not be read.
+
+Input
+  - databaseStatuses: The status of each database, as returned by GetStatus.
+  - dataSources: Datasources expected to be registered in the Senzing configuration.
+
+Output
+  - A description of each problem.  Empty if the databases are healthy.
+*/
+func EvaluateHealth(databaseStatuses []DatabaseStatus, dataSources []string) []string {
+	result := []string{}
+
+	if len(databaseStatuses) == 0 {
+		return append(result, "no databases specified")
+	}
+
+	for _, databaseStatus := range databaseStatuses {
+		// Errors reading a database leave the rest of its status unknown, so they are the problems reported.
+
+		switch {
+		case !databaseStatus.IsReachable:
+			problem := databaseStatus.DatabaseURL + " is not reachable"
+			if len(databaseStatus.Errors) > 0 {
+				problem += ": " + strings.Join(databaseStatus.Errors, "; ")
+			}
+
+			result = append(result, problem)
+		case len(databaseStatus.Errors) > 0:
+			for _, databaseError := range databaseStatus.Errors {
+				result = append(result, databaseStatus.DatabaseURL+": "+databaseError)
+			}
+		case !databaseStatus.IsSchemaComplete:
+			result = append(
+				result,
+				fmt.Sprintf(
+					"%s has an incomplete Senzing schema; missing tables: %s",
+					databaseStatus.DatabaseURL,
+					strings.Join(databaseStatus.MissingTables, ", "),
+				),
+			)
+		case databaseStatus.IsPrimary && databaseStatus.DefaultConfigID == 0:
+			result = append(result, databaseStatus.DatabaseURL+" has no default Senzing configuration")
+		case databaseStatus.IsPrimary:
+			for _, dataSource := range dataSources {
+				if !slices.Contains(databaseStatus.Datasources, strings.ToUpper(dataSource)) {
+					result = append(result, "datasource "+dataSource+" is not registered")
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// --- Logging ----------------------------------------------------------------
+
+// Get the Logger singleton.
+func (senzingStatus *BasicSenzingStatus) getLogger() logging.Logging {
+	var err error
+
+	if senzingStatus.logger == nil {
+		options := []interface{}{
+			&logging.OptionCallerSkip{Value: OptionCallerSkip4},
+			logging.OptionMessageFields{Value: []string{"id", "text"}},
+		}
+
+		senzingStatus.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return senzingStatus.logger
+}
+
+// Log message.
+func (senzingStatus *BasicSenzingStatus) log(messageNumber int, details ...interface{}) {
+	senzingStatus.getLogger().Log(messageNumber, details...)
+}
+
+// Debug.
+func (senzingStatus *BasicSenzingStatus) debug(messageNumber int, details ...interface{}) {
+	details = append(details, debugOptions...)
+	senzingStatus.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method entry.
+func (senzingStatus *BasicSenzingStatus) traceEntry(messageNumber int, details ...interface{}) {
+	details = append(details, traceOptions...)
+	senzingStatus.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method exit.
+func (senzingStatus *BasicSenzingStatus) traceExit(messageNumber int, details ...interface{}) {
+	details = append(details, traceOptions...)
+	senzingStatus.getLogger().Log(messageNumber, details...)
+}
+
+// --- Dependent services -----------------------------------------------------
+
+// Create an abstract factory.  The caller is responsible for closing it.
+func (senzingStatus *BasicSenzingStatus) createAbstractFactory() (senzing.SzAbstractFactory, error) {
+	senzingInstanceName := senzingStatus.SenzingInstanceName
+	if len(senzingInstanceName) == 0 {
+		senzingInstanceName = fmt.Sprintf("senzing init-database status at %s", time.Now())
+	}
+
+	result, err := szfactorycreator.CreateCoreAbstractFactory(
+		senzingInstanceName,
+		senzingStatus.SenzingSettings,
+		senzingStatus.SenzingVerboseLogging,
+		senzing.SzInitializeWithDefaultConfiguration,
+	)
+
+	return result, wraperror.Errorf(err, "CreateCoreAbstractFactory")
+}
+
+// --- Status -----------------------------------------------------------------
+
+// Inspect a single database.  Also returns record counts keyed by datasource identifier.
+func (senzingStatus *BasicSenzingStatus) getDatabaseStatus(
+	ctx context.Context,
+	databaseURL string,
+) (DatabaseStatus, map[int64]int64) {
+	result := DatabaseStatus{
+		DatabaseURL:  senzingschema.RedactDatabaseURL(databaseURL),
+		Datasources:  []string{},
+		RecordCounts: map[string]int64{},
+	}
+	recordCounts := map[int64]int64{}
+
+	parsedURL, err := url.Parse(databaseURL)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+
+		return result, recordCounts
+	}
+
+	databaseConnector, err := connector.NewConnector(ctx, databaseURL)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+
+		return result, recordCounts
+	}
+
+	database := sql.OpenDB(databaseConnector)
+
+	defer func() { _ = database.Close() }()
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeoutInSeconds*time.Second)
+	defer cancel()
+
+	err = database.PingContext(ctxTimeout)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+
+		return result, recordCounts
+	}
+
+	result.IsReachable = true
+
+	// Compare the tables in the database with the tables the Senzing schema creates.
+
+	expectedTables, err := senzingStatus.getExpectedTables(ctx, parsedURL.Scheme)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+
+		return result, recordCounts
+	}
+
+	for _, table := range expectedTables {
+		if !hasTable(ctx, database, table) {
+			result.MissingTables = append(result.MissingTables, table)
+		}
+	}
+
+	result.IsSchemaInstalled = len(result.MissingTables) < len(expectedTables)
+	result.IsSchemaComplete = len(expectedTables) > 0 && len(result.MissingTables) == 0
+
+	// Count records and redo records.
+
+	if slices.Contains(expectedTables, tableOfRecords) && !slices.Contains(result.MissingTables, tableOfRecords) {
+		recordCounts, err = countRecords(ctx, database)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+	}
+
+	if slices.Contains(expectedTables, tableOfRedo) && !slices.Contains(result.MissingTables, tableOfRedo) {
+		err = database.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+tableOfRedo).Scan(&result.RedoCount)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+	}
+
+	return result, recordCounts
+}
+
+// Get the default configuration identifier and the datasources it defines.
+func (senzingStatus *BasicSenzingStatus) getConfiguration(ctx context.Context) (int64, map[int64]string, error) {
+	var (
+		configID int64
+		result   = map[int64]string{}
+	)
+
+	szAbstractFactory, err := senzingStatus.createAbstractFactory()
+	if err != nil {
+		return configID, result, wraperror.Errorf(err, "createAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	if err != nil {
+		return configID, result, wraperror.Errorf(err, "CreateConfigManager")
+	}
+
+	defer func() { _ = szConfigManager.Destroy(ctx) }()
+
+	configID, err = szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return configID, result, wraperror.Errorf(err, "GetDefaultConfigID")
+	}
+
+	if configID == 0 {
+		return configID, result, nil
+	}
+
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		return configID, result, wraperror.Errorf(err, "CreateConfigFromConfigID: %d", configID)
+	}
+
+	registryJSON, err := szConfig.GetDataSourceRegistry(ctx)
+	if err != nil {
+		return configID, result, wraperror.Errorf(err, "GetDataSourceRegistry")
+	}
+
+	registry := dataSourceRegistry{}
+
+	err = json.Unmarshal([]byte(registryJSON), &registry)
+	if err != nil {
+		return configID, result, wraperror.Errorf(err, "json.Unmarshal: %s", registryJSON)
+	}
+
+	for _, dataSource := range registry.DataSources {
+		result[dataSource.DsrcID] = dataSource.DsrcCode
+	}
+
+	return configID, result, nil
+}
+
+// List the tables created by the SQL file for the database scheme.
+func (senzingStatus *BasicSenzingStatus) getExpectedTables(ctx context.Context, scheme string) ([]string, error) {
+	sqlFile := senzingStatus.SQLFile
+	if len(sqlFile) == 0 {
+		parser, err := settingsparser.New(senzingStatus.SenzingSettings)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "settingsparser.New")
+		}
+
+		resourcePath, err := parser.GetResourcePath(ctx)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "GetResourcePath")
+		}
+
+		sqlFile, err = senzingschema.GetSQLFile(resourcePath, scheme)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "GetSQLFile")
+		}
+	}
+
+	return getTablesFromSQLFile(sqlFile)
+}
+
+// Add a test record to Senzing, then delete it.
+func (senzingStatus *BasicSenzingStatus) selfTest(ctx context.Context) error {
+	dataSource := selfTestDataSource
+	if len(senzingStatus.DataSources) > 0 {
+		dataSource = senzingStatus.DataSources[0]
+	}
+
+	recordID := fmt.Sprintf("%s-%d", selfTestRecordIDPrefix, time.Now().UnixNano())
+	recordDefinition := fmt.Sprintf(
+		`{"DATA_SOURCE": %q, "RECORD_ID": %q, "NAME_FULL": "Init Database Healthcheck"}`,
+		dataSource,
+		recordID,
+	)
+
+	szAbstractFactory, err := senzingStatus.createAbstractFactory()
+	if err != nil {
+		return wraperror.Errorf(err, "createAbstractFactory")
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "CreateEngine")
+	}
+
+	defer func() { _ = szEngine.Destroy(ctx) }()
+
+	_, err = szEngine.AddRecord(ctx, dataSource, recordID, recordDefinition, senzing.SzNoFlags)
+	if err != nil {
+		return wraperror.Errorf(err, "AddRecord DataSource: %s; RecordID: %s", dataSource, recordID)
+	}
+
+	_, err = szEngine.DeleteRecord(ctx, dataSource, recordID, senzing.SzNoFlags)
+	if err != nil {
+		return wraperror.Errorf(err, "DeleteRecord DataSource: %s; RecordID: %s", dataSource, recordID)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Count records in the database by datasource identifier.
+func countRecords(ctx context.Context, database *sql.DB) (map[int64]int64, error) {
+	result := map[int64]int64{}
+
+	rows, err := database.QueryContext(ctx, "SELECT DSRC_ID, COUNT(*) FROM "+tableOfRecords+" GROUP BY DSRC_ID")
+	if err != nil {
+		return result, wraperror.Errorf(err, "QueryContext")
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var dataSourceID, count int64
+
+		err = rows.Scan(&dataSourceID, &count)
+		if err != nil {
+			return result, wraperror.Errorf(err, "Scan")
+		}
+
+		result[dataSourceID] = count
+	}
+
+	return result, wraperror.Errorf(rows.Err(), wraperror.NoMessage)
+}
+
+// Extract the names of tables created by a file of SQL.
+func getTablesFromSQLFile(sqlFile string) ([]string, error) {
+	var result []string
+
+	file, err := os.Open(filepath.Clean(sqlFile))
+	if err != nil {
+		return result, wraperror.Errorf(err, "os.Open: %s", sqlFile)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, bufio.MaxScanTokenSize*16) //nolint:mnd
+
+	for scanner.Scan() {
+		matches := createTableRegexp.FindStringSubmatch(scanner.Text())
+		if len(matches) < 2 { //nolint:mnd
+			continue
+		}
+
+		// Remove schema prefixes and identifier quoting.
+
+		table := matches[1]
+		if index := strings.LastIndex(table, "."); index >= 0 {
+			table = table[index+1:]
+		}
+
+		table = strings.ToUpper(strings.Trim(table, "\"`[]"))
+		if !slices.Contains(result, table) {
+			result = append(result, table)
+		}
+	}
+
+	return result, wraperror.Errorf(scanner.Err(), "Scan: %s", sqlFile)
+}
+
+// Determine if a table exists by selecting nothing from it.
+func hasTable(ctx context.Context, database *sql.DB, table string) bool {
+	rows, err := database.QueryContext(ctx, "SELECT 1 FROM "+table+" WHERE 1 = 0")
+	if err != nil {
+		return false
+	}
+
+	_ = rows.Close()
+
+	return rows.Err() == nil
+}